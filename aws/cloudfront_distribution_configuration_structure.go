@@ -190,15 +190,38 @@ func flattenCacheBehaviors(cbs *cloudfront.CacheBehaviors) []interface{} {
 func expandCloudFrontDefaultCacheBehavior(m map[string]interface{}) *cloudfront.DefaultCacheBehavior {
 	dcb := &cloudfront.DefaultCacheBehavior{
 		Compress:               aws.Bool(m["compress"].(bool)),
-		DefaultTTL:             aws.Int64(int64(m["default_ttl"].(int))),
 		FieldLevelEncryptionId: aws.String(m["field_level_encryption_id"].(string)),
-		ForwardedValues:        expandForwardedValues(m["forwarded_values"].([]interface{})[0].(map[string]interface{})),
-		MaxTTL:                 aws.Int64(int64(m["max_ttl"].(int))),
-		MinTTL:                 aws.Int64(int64(m["min_ttl"].(int))),
 		TargetOriginId:         aws.String(m["target_origin_id"].(string)),
 		ViewerProtocolPolicy:   aws.String(m["viewer_protocol_policy"].(string)),
 	}
 
+	if v, ok := m["forwarded_values"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		dcb.ForwardedValues = expandForwardedValues(v[0].(map[string]interface{}))
+	}
+
+	// TTLs cannot be set alongside a cache policy, which defines its own.
+	if v, ok := m["cache_policy_id"].(string); ok && v != "" {
+		dcb.CachePolicyId = aws.String(v)
+	} else {
+		dcb.DefaultTTL = aws.Int64(int64(m["default_ttl"].(int)))
+		dcb.MaxTTL = aws.Int64(int64(m["max_ttl"].(int)))
+		dcb.MinTTL = aws.Int64(int64(m["min_ttl"].(int)))
+	}
+
+	if v, ok := m["origin_request_policy_id"].(string); ok && v != "" {
+		dcb.OriginRequestPolicyId = aws.String(v)
+	}
+
+	if v, ok := m["realtime_log_config_arn"].(string); ok && v != "" {
+		dcb.RealtimeLogConfigArn = aws.String(v)
+	}
+
+	if v, ok := m["trusted_key_groups"]; ok {
+		dcb.TrustedKeyGroups = expandTrustedKeyGroups(v.([]interface{}))
+	} else {
+		dcb.TrustedKeyGroups = expandTrustedKeyGroups([]interface{}{})
+	}
+
 	if v, ok := m["trusted_signers"]; ok {
 		dcb.TrustedSigners = expandTrustedSigners(v.([]interface{}))
 	} else {
@@ -225,15 +248,38 @@ func expandCloudFrontDefaultCacheBehavior(m map[string]interface{}) *cloudfront.
 func expandCacheBehavior(m map[string]interface{}) *cloudfront.CacheBehavior {
 	cb := &cloudfront.CacheBehavior{
 		Compress:               aws.Bool(m["compress"].(bool)),
-		DefaultTTL:             aws.Int64(int64(m["default_ttl"].(int))),
 		FieldLevelEncryptionId: aws.String(m["field_level_encryption_id"].(string)),
-		ForwardedValues:        expandForwardedValues(m["forwarded_values"].([]interface{})[0].(map[string]interface{})),
-		MaxTTL:                 aws.Int64(int64(m["max_ttl"].(int))),
-		MinTTL:                 aws.Int64(int64(m["min_ttl"].(int))),
 		TargetOriginId:         aws.String(m["target_origin_id"].(string)),
 		ViewerProtocolPolicy:   aws.String(m["viewer_protocol_policy"].(string)),
 	}
 
+	if v, ok := m["forwarded_values"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cb.ForwardedValues = expandForwardedValues(v[0].(map[string]interface{}))
+	}
+
+	// TTLs cannot be set alongside a cache policy, which defines its own.
+	if v, ok := m["cache_policy_id"].(string); ok && v != "" {
+		cb.CachePolicyId = aws.String(v)
+	} else {
+		cb.DefaultTTL = aws.Int64(int64(m["default_ttl"].(int)))
+		cb.MaxTTL = aws.Int64(int64(m["max_ttl"].(int)))
+		cb.MinTTL = aws.Int64(int64(m["min_ttl"].(int)))
+	}
+
+	if v, ok := m["origin_request_policy_id"].(string); ok && v != "" {
+		cb.OriginRequestPolicyId = aws.String(v)
+	}
+
+	if v, ok := m["realtime_log_config_arn"].(string); ok && v != "" {
+		cb.RealtimeLogConfigArn = aws.String(v)
+	}
+
+	if v, ok := m["trusted_key_groups"]; ok {
+		cb.TrustedKeyGroups = expandTrustedKeyGroups(v.([]interface{}))
+	} else {
+		cb.TrustedKeyGroups = expandTrustedKeyGroups([]interface{}{})
+	}
+
 	if v, ok := m["trusted_signers"]; ok {
 		cb.TrustedSigners = expandTrustedSigners(v.([]interface{}))
 	} else {
@@ -268,9 +314,21 @@ func flattenCloudFrontDefaultCacheBehavior(dcb *cloudfront.DefaultCacheBehavior)
 		"min_ttl":                   aws.Int64Value(dcb.MinTTL),
 	}
 
+	if dcb.CachePolicyId != nil {
+		m["cache_policy_id"] = aws.StringValue(dcb.CachePolicyId)
+	}
 	if dcb.ForwardedValues != nil {
 		m["forwarded_values"] = []interface{}{flattenForwardedValues(dcb.ForwardedValues)}
 	}
+	if dcb.OriginRequestPolicyId != nil {
+		m["origin_request_policy_id"] = aws.StringValue(dcb.OriginRequestPolicyId)
+	}
+	if dcb.RealtimeLogConfigArn != nil {
+		m["realtime_log_config_arn"] = aws.StringValue(dcb.RealtimeLogConfigArn)
+	}
+	if dcb.TrustedKeyGroups != nil && len(dcb.TrustedKeyGroups.Items) > 0 {
+		m["trusted_key_groups"] = flattenTrustedKeyGroups(dcb.TrustedKeyGroups)
+	}
 	if len(dcb.TrustedSigners.Items) > 0 {
 		m["trusted_signers"] = flattenTrustedSigners(dcb.TrustedSigners)
 	}
@@ -305,9 +363,21 @@ func flattenCacheBehavior(cb *cloudfront.CacheBehavior) map[string]interface{} {
 	m["target_origin_id"] = aws.StringValue(cb.TargetOriginId)
 	m["min_ttl"] = int(aws.Int64Value(cb.MinTTL))
 
+	if cb.CachePolicyId != nil {
+		m["cache_policy_id"] = aws.StringValue(cb.CachePolicyId)
+	}
 	if cb.ForwardedValues != nil {
 		m["forwarded_values"] = []interface{}{flattenForwardedValues(cb.ForwardedValues)}
 	}
+	if cb.OriginRequestPolicyId != nil {
+		m["origin_request_policy_id"] = aws.StringValue(cb.OriginRequestPolicyId)
+	}
+	if cb.RealtimeLogConfigArn != nil {
+		m["realtime_log_config_arn"] = aws.StringValue(cb.RealtimeLogConfigArn)
+	}
+	if cb.TrustedKeyGroups != nil && len(cb.TrustedKeyGroups.Items) > 0 {
+		m["trusted_key_groups"] = flattenTrustedKeyGroups(cb.TrustedKeyGroups)
+	}
 	if len(cb.TrustedSigners.Items) > 0 {
 		m["trusted_signers"] = flattenTrustedSigners(cb.TrustedSigners)
 	}
@@ -348,6 +418,26 @@ func expandTrustedSigners(s []interface{}) *cloudfront.TrustedSigners {
 	return &ts
 }
 
+func expandTrustedKeyGroups(s []interface{}) *cloudfront.TrustedKeyGroups {
+	var tkg cloudfront.TrustedKeyGroups
+	if len(s) > 0 {
+		tkg.Quantity = aws.Int64(int64(len(s)))
+		tkg.Items = expandStringList(s)
+		tkg.Enabled = aws.Bool(true)
+	} else {
+		tkg.Quantity = aws.Int64(0)
+		tkg.Enabled = aws.Bool(false)
+	}
+	return &tkg
+}
+
+func flattenTrustedKeyGroups(tkg *cloudfront.TrustedKeyGroups) []interface{} {
+	if tkg.Items != nil {
+		return flattenStringList(tkg.Items)
+	}
+	return []interface{}{}
+}
+
 func flattenTrustedSigners(ts *cloudfront.TrustedSigners) []interface{} {
 	if ts.Items != nil {
 		return flattenStringList(ts.Items)
@@ -570,6 +660,12 @@ func expandOrigin(m map[string]interface{}) *cloudfront.Origin {
 	if v, ok := m["origin_path"]; ok {
 		origin.OriginPath = aws.String(v.(string))
 	}
+
+	if v, ok := m["origin_shield"]; ok {
+		if s := v.([]interface{}); len(s) > 0 && s[0] != nil {
+			origin.OriginShield = expandOriginShield(s[0].(map[string]interface{}))
+		}
+	}
 	if v, ok := m["s3_origin_config"]; ok {
 		if s := v.([]interface{}); len(s) > 0 {
 			origin.S3OriginConfig = expandS3OriginConfig(s[0].(map[string]interface{}))
@@ -600,6 +696,9 @@ func flattenOrigin(or *cloudfront.Origin) map[string]interface{} {
 	if or.OriginPath != nil {
 		m["origin_path"] = aws.StringValue(or.OriginPath)
 	}
+	if or.OriginShield != nil && aws.BoolValue(or.OriginShield.Enabled) {
+		m["origin_shield"] = []interface{}{flattenOriginShield(or.OriginShield)}
+	}
 	if or.S3OriginConfig != nil && aws.StringValue(or.S3OriginConfig.OriginAccessIdentity) != "" {
 		m["s3_origin_config"] = []interface{}{flattenS3OriginConfig(or.S3OriginConfig)}
 	}
@@ -722,6 +821,11 @@ func originHash(v interface{}) int {
 	if v, ok := m["origin_path"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 	}
+	if v, ok := m["origin_shield"]; ok {
+		if s := v.([]interface{}); len(s) > 0 && s[0] != nil {
+			buf.WriteString(fmt.Sprintf("%d-", originShieldHash((s[0].(map[string]interface{})))))
+		}
+	}
 	if v, ok := m["s3_origin_config"]; ok {
 		if s := v.([]interface{}); len(s) > 0 && s[0] != nil {
 			buf.WriteString(fmt.Sprintf("%d-", s3OriginConfigHash((s[0].(map[string]interface{})))))
@@ -768,6 +872,30 @@ func failoverCriteriaHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+func expandOriginShield(m map[string]interface{}) *cloudfront.OriginShield {
+	return &cloudfront.OriginShield{
+		Enabled:            aws.Bool(m["enabled"].(bool)),
+		OriginShieldRegion: aws.String(m["origin_shield_region"].(string)),
+	}
+}
+
+func flattenOriginShield(o *cloudfront.OriginShield) map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":              aws.BoolValue(o.Enabled),
+		"origin_shield_region": aws.StringValue(o.OriginShieldRegion),
+	}
+}
+
+// Assemble the hash for the aws_cloudfront_distribution origin_shield
+// TypeList attribute.
+func originShieldHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%t-", m["enabled"].(bool)))
+	buf.WriteString(fmt.Sprintf("%s-", m["origin_shield_region"].(string)))
+	return hashcode.String(buf.String())
+}
+
 func expandCustomHeaders(s *schema.Set) *cloudfront.CustomHeaders {
 	qty := 0
 	items := []*cloudfront.OriginCustomHeader{}
@@ -1100,6 +1228,37 @@ func flattenViewerCertificate(vc *cloudfront.ViewerCertificate) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenCloudfrontActiveTrustedKeyGroups(atkg *cloudfront.ActiveTrustedKeyGroups) []interface{} {
+	if atkg == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.BoolValue(atkg.Enabled),
+		"items":   flattenCloudfrontKGKeyPairIds(atkg.Items),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenCloudfrontKGKeyPairIds(keyPairIds []*cloudfront.KGKeyPairIds) []interface{} {
+	result := make([]interface{}, 0, len(keyPairIds))
+
+	for _, keyPairId := range keyPairIds {
+		m := map[string]interface{}{
+			"key_group_id": aws.StringValue(keyPairId.KeyGroupId),
+		}
+
+		if keyPairId.KeyPairIds != nil {
+			m["key_pair_ids"] = aws.StringValueSlice(keyPairId.KeyPairIds.Items)
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
 func flattenCloudfrontActiveTrustedSigners(ats *cloudfront.ActiveTrustedSigners) []interface{} {
 	if ats == nil {
 		return []interface{}{}
@@ -309,6 +309,34 @@ func TestCloudFrontStructure_expandCloudFrontDefaultCacheBehavior(t *testing.T)
 	}
 }
 
+func TestCloudFrontStructure_expandCloudFrontDefaultCacheBehavior_cachePolicy(t *testing.T) {
+	data := defaultCacheBehaviorConf()
+	delete(data, "forwarded_values")
+	data["cache_policy_id"] = "658327ea-f89d-4fab-a63d-7e88639e58f6"
+	data["origin_request_policy_id"] = "216adef6-5c7f-47e4-b989-5492eafa07d3"
+	data["realtime_log_config_arn"] = "arn:aws:cloudfront::123456789012:realtime-log-config/test" //lintignore:AWSAT005
+
+	dcb := expandCloudFrontDefaultCacheBehavior(data)
+	if dcb == nil {
+		t.Fatalf("ExpandDefaultCacheBehavior returned nil")
+	}
+	if aws.StringValue(dcb.CachePolicyId) != "658327ea-f89d-4fab-a63d-7e88639e58f6" {
+		t.Fatalf("Expected CachePolicyId to be 658327ea-f89d-4fab-a63d-7e88639e58f6, got %v", aws.StringValue(dcb.CachePolicyId))
+	}
+	if aws.StringValue(dcb.OriginRequestPolicyId) != "216adef6-5c7f-47e4-b989-5492eafa07d3" {
+		t.Fatalf("Expected OriginRequestPolicyId to be 216adef6-5c7f-47e4-b989-5492eafa07d3, got %v", aws.StringValue(dcb.OriginRequestPolicyId))
+	}
+	if aws.StringValue(dcb.RealtimeLogConfigArn) != data["realtime_log_config_arn"] {
+		t.Fatalf("Expected RealtimeLogConfigArn to be %v, got %v", data["realtime_log_config_arn"], aws.StringValue(dcb.RealtimeLogConfigArn))
+	}
+	if dcb.ForwardedValues != nil {
+		t.Fatalf("Expected ForwardedValues to be nil, got %v", dcb.ForwardedValues)
+	}
+	if dcb.DefaultTTL != nil || dcb.MaxTTL != nil || dcb.MinTTL != nil {
+		t.Fatalf("Expected TTLs to be nil, got %v, %v, %v", dcb.DefaultTTL, dcb.MaxTTL, dcb.MinTTL)
+	}
+}
+
 func TestCloudFrontStructure_expandTrustedSigners(t *testing.T) {
 	data := trustedSignersConf()
 	ts := expandTrustedSigners(data)
@@ -347,6 +375,39 @@ func TestCloudFrontStructure_expandTrustedSigners_empty(t *testing.T) {
 	}
 }
 
+func TestCloudFrontStructure_expandTrustedKeyGroups(t *testing.T) {
+	data := []interface{}{"2d1a6a2e-1f3b-4f0c-9a4b-1e2f3a4b5c6d"}
+	tkg := expandTrustedKeyGroups(data)
+	if *tkg.Quantity != 1 {
+		t.Fatalf("Expected Quantity to be 1, got %v", *tkg.Quantity)
+	}
+	if !*tkg.Enabled {
+		t.Fatalf("Expected Enabled to be true, got %v", *tkg.Enabled)
+	}
+	if !reflect.DeepEqual(tkg.Items, expandStringList(data)) {
+		t.Fatalf("Expected Items to be %v, got %v", data, tkg.Items)
+	}
+
+	out := flattenTrustedKeyGroups(tkg)
+	if !reflect.DeepEqual(data, out) {
+		t.Fatalf("Expected out to be %v, got %v", data, out)
+	}
+}
+
+func TestCloudFrontStructure_expandTrustedKeyGroups_empty(t *testing.T) {
+	data := []interface{}{}
+	tkg := expandTrustedKeyGroups(data)
+	if *tkg.Quantity != 0 {
+		t.Fatalf("Expected Quantity to be 0, got %v", *tkg.Quantity)
+	}
+	if *tkg.Enabled {
+		t.Fatalf("Expected Enabled to be false, got %v", *tkg.Enabled)
+	}
+	if tkg.Items != nil {
+		t.Fatalf("Expected Items to be nil, got %v", tkg.Items)
+	}
+}
+
 func TestCloudFrontStructure_expandLambdaFunctionAssociations(t *testing.T) {
 	data := lambdaFunctionAssociationsConf()
 	lfa := expandLambdaFunctionAssociations(data.List())
@@ -643,6 +704,27 @@ func TestCloudFrontStructure_flattenOrigin(t *testing.T) {
 	}
 }
 
+func TestCloudFrontStructure_expandOrigin_originShield(t *testing.T) {
+	data := originWithCustomConf()
+	data["origin_shield"] = []interface{}{map[string]interface{}{
+		"enabled":              true,
+		"origin_shield_region": "us-east-1", //lintignore:AWSAT003
+	}}
+
+	or := expandOrigin(data)
+	if !aws.BoolValue(or.OriginShield.Enabled) {
+		t.Fatalf("Expected OriginShield.Enabled to be true, got %v", aws.BoolValue(or.OriginShield.Enabled))
+	}
+	if aws.StringValue(or.OriginShield.OriginShieldRegion) != "us-east-1" { //lintignore:AWSAT003
+		t.Fatalf("Expected OriginShield.OriginShieldRegion to be us-east-1, got %v", aws.StringValue(or.OriginShield.OriginShieldRegion))
+	}
+
+	out := flattenOrigin(or)
+	if !reflect.DeepEqual(out["origin_shield"], data["origin_shield"]) {
+		t.Fatalf("Expected out[origin_shield] to be %v, got %v", data["origin_shield"], out["origin_shield"])
+	}
+}
+
 func TestCloudFrontStructure_expandCustomHeaders(t *testing.T) {
 	in := originCustomHeadersConf()
 	chs := expandCustomHeaders(in)
@@ -7,8 +7,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -163,8 +165,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/assumerole"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/auditlog"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retry"
+	tfsync "github.com/terraform-providers/terraform-provider-aws/aws/internal/sync"
 )
 
 type Config struct {
@@ -176,21 +183,20 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
-	AssumeRoleARN               string
-	AssumeRoleDurationSeconds   int
-	AssumeRoleExternalID        string
-	AssumeRolePolicy            string
-	AssumeRolePolicyARNs        []string
-	AssumeRoleSessionName       string
-	AssumeRoleTags              map[string]string
-	AssumeRoleTransitiveTagKeys []string
+	AuditLogConfig *auditlog.Config
+
+	AssumeRoles               []*assumerole.Role
+	AssumeRoleWithWebIdentity *assumerole.WebIdentity
 
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints        map[string]string
-	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
-	Insecure         bool
+	ConcurrencyLimits map[string]int
+	DefaultTagsConfig *keyvaluetags.DefaultConfig
+	Endpoints         map[string]string
+	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
+	Insecure          bool
+	RetryConfig       *retry.Config
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -245,6 +251,7 @@ type AWSClient struct {
 	dataexchangeconn                    *dataexchange.DataExchange
 	datapipelineconn                    *datapipeline.DataPipeline
 	datasyncconn                        *datasync.DataSync
+	DefaultTagsConfig                   *keyvaluetags.DefaultConfig
 	daxconn                             *dax.DAX
 	devicefarmconn                      *devicefarm.DeviceFarm
 	dlmconn                             *dlm.DLM
@@ -381,6 +388,21 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.region, client.dnsSuffix)
 }
 
+// ForResourceType returns a client for the Terraform resource type, e.g. aws_instance,
+// with the provider ignore_tags configuration for that resource type applied.
+func (client *AWSClient) ForResourceType(resourceType string) *AWSClient {
+	ignoreTagsConfig := client.IgnoreTagsConfig.ForResourceType(resourceType)
+
+	if ignoreTagsConfig == client.IgnoreTagsConfig {
+		return client
+	}
+
+	scopedClient := *client
+	scopedClient.IgnoreTagsConfig = ignoreTagsConfig
+
+	return &scopedClient
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
@@ -392,30 +414,22 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	awsbaseConfig := &awsbase.Config{
-		AccessKey:                   c.AccessKey,
-		AssumeRoleARN:               c.AssumeRoleARN,
-		AssumeRoleDurationSeconds:   c.AssumeRoleDurationSeconds,
-		AssumeRoleExternalID:        c.AssumeRoleExternalID,
-		AssumeRolePolicy:            c.AssumeRolePolicy,
-		AssumeRolePolicyARNs:        c.AssumeRolePolicyARNs,
-		AssumeRoleSessionName:       c.AssumeRoleSessionName,
-		AssumeRoleTags:              c.AssumeRoleTags,
-		AssumeRoleTransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
-		CallerDocumentationURL:      "https://registry.terraform.io/providers/hashicorp/aws",
-		CallerName:                  "Terraform AWS Provider",
-		CredsFilename:               c.CredsFilename,
-		DebugLogging:                logging.IsDebugOrHigher(),
-		IamEndpoint:                 c.Endpoints["iam"],
-		Insecure:                    c.Insecure,
-		MaxRetries:                  c.MaxRetries,
-		Profile:                     c.Profile,
-		Region:                      c.Region,
-		SecretKey:                   c.SecretKey,
-		SkipCredsValidation:         c.SkipCredsValidation,
-		SkipMetadataApiCheck:        c.SkipMetadataApiCheck,
-		SkipRequestingAccountId:     c.SkipRequestingAccountId,
-		StsEndpoint:                 c.Endpoints["sts"],
-		Token:                       c.Token,
+		AccessKey:               c.AccessKey,
+		CallerDocumentationURL:  "https://registry.terraform.io/providers/hashicorp/aws",
+		CallerName:              "Terraform AWS Provider",
+		CredsFilename:           c.CredsFilename,
+		DebugLogging:            logging.IsDebugOrHigher(),
+		IamEndpoint:             c.Endpoints["iam"],
+		Insecure:                c.Insecure,
+		MaxRetries:              c.MaxRetries,
+		Profile:                 c.Profile,
+		Region:                  c.Region,
+		SecretKey:               c.SecretKey,
+		SkipCredsValidation:     c.SkipCredsValidation,
+		SkipMetadataApiCheck:    c.SkipMetadataApiCheck,
+		SkipRequestingAccountId: c.SkipRequestingAccountId,
+		StsEndpoint:             c.Endpoints["sts"],
+		Token:                   c.Token,
 		UserAgentProducts: []*awsbase.UserAgentProduct{
 			{Name: "APN", Version: "1.0"},
 			{Name: "HashiCorp", Version: "1.0"},
@@ -424,11 +438,87 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	// A single assume_role hop is handled by the base session. A web identity role or
+	// further hops are chained onto the base session's credentials afterwards.
+	assumeRoleConfig := &assumerole.Config{
+		WebIdentity: c.AssumeRoleWithWebIdentity,
+		Roles:       c.AssumeRoles,
+	}
+
+	var creds *credentials.Credentials
+
+	if assumeRoleConfig.WebIdentity != nil {
+		// The base session requires valid credentials, so resolve the web identity role
+		// and any further hops first and hand the base session the current values.
+		// The session's credentials are replaced with the refreshing chain below.
+		stsSess, err := session.NewSession(&aws.Config{
+			Credentials: credentials.AnonymousCredentials,
+			HTTPClient:  cleanhttp.DefaultClient(),
+			MaxRetries:  aws.Int(c.MaxRetries),
+			Region:      aws.String(c.Region),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		creds = assumeRoleConfig.Credentials(stsSess, nil, &aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})
+
+		value, err := creds.Get()
+		if err != nil {
+			return nil, fmt.Errorf("error assuming role (%s) with web identity: %w", assumeRoleConfig.LastRoleARN(), err)
+		}
+
+		awsbaseConfig.AccessKey = value.AccessKeyID
+		awsbaseConfig.SecretKey = value.SecretAccessKey
+		awsbaseConfig.Token = value.SessionToken
+	} else if len(assumeRoleConfig.Roles) > 0 {
+		role := assumeRoleConfig.Roles[0]
+
+		awsbaseConfig.AssumeRoleARN = role.ARN
+		awsbaseConfig.AssumeRoleDurationSeconds = role.DurationSeconds
+		awsbaseConfig.AssumeRoleExternalID = role.ExternalID
+		awsbaseConfig.AssumeRolePolicy = role.Policy
+		awsbaseConfig.AssumeRolePolicyARNs = role.PolicyARNs
+		awsbaseConfig.AssumeRoleSessionName = role.SessionName
+		awsbaseConfig.AssumeRoleTags = role.Tags
+		awsbaseConfig.AssumeRoleTransitiveTagKeys = role.TransitiveTagKeys
+
+		assumeRoleConfig.Roles = assumeRoleConfig.Roles[1:]
+	}
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if creds == nil && len(assumeRoleConfig.Roles) > 0 {
+		creds = assumeRoleConfig.Credentials(sess, sess.Config.Credentials, &aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})
+
+		if _, err := creds.Get(); err != nil {
+			return nil, fmt.Errorf("error assuming role (%s): %w", assumeRoleConfig.LastRoleARN(), err)
+		}
+	}
+
+	if creds != nil {
+		sess.Config.Credentials = creds
+
+		if !c.SkipRequestingAccountId {
+			accountID, partition, err = assumeRoleConfig.AccountIDAndPartition()
+			if err != nil {
+				return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+			}
+		}
+	}
+
+	// Install the provider-wide retry, rate limiting and concurrency limiting
+	// handlers before any service clients are created from copies of the session.
+	c.RetryConfig.ConfigureSession(sess)
+	tfsync.NewServiceSemaphores(c.ConcurrencyLimits).ConfigureSession(sess)
+
+	if err := c.AuditLogConfig.ConfigureSession(sess); err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider audit log: %w", err)
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -485,6 +575,7 @@ func (c *Config) Client() (interface{}, error) {
 		dataexchangeconn:                    dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dataexchange"])})),
 		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
 		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
+		DefaultTagsConfig:                   c.DefaultTagsConfig,
 		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
 		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
 		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
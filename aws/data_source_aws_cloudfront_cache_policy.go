@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+)
+
+func dataSourceAwsCloudFrontCachePolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontCachePolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"max_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"parameters_in_cache_key_and_forwarded_to_origin": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cookies_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cookie_behavior": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"cookies": dataSourceCloudFrontPolicyItemsSchema(),
+								},
+							},
+						},
+						"enable_accept_encoding_brotli": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_accept_encoding_gzip": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"headers_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header_behavior": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"headers": dataSourceCloudFrontPolicyItemsSchema(),
+								},
+							},
+						},
+						"query_strings_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query_string_behavior": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"query_strings": dataSourceCloudFrontPolicyItemsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	id := d.Get("id").(string)
+
+	if id == "" {
+		name := d.Get("name").(string)
+		cachePolicy, err := finder.CachePolicyByName(conn, name)
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudFront Cache Policy (%s): %w", name, err)
+		}
+
+		id = aws.StringValue(cachePolicy.Id)
+	}
+
+	output, err := finder.CachePolicyByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Cache Policy (%s): %w", id, err)
+	}
+
+	d.SetId(aws.StringValue(output.CachePolicy.Id))
+
+	return setCloudFrontCachePolicyConfig(d, output.CachePolicy.CachePolicyConfig, output.ETag)
+}
+
+// dataSourceCloudFrontPolicyItemsSchema returns the computed schema for a list of cookie, header or query string names
+// used in cache and origin request policies.
+func dataSourceCloudFrontPolicyItemsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"items": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
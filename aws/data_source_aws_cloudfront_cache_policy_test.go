@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSCloudFrontDataSourceCachePolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSource1Name := "data.aws_cloudfront_cache_policy.by_id"
+	dataSource2Name := "data.aws_cloudfront_cache_policy.by_name"
+	resourceName := "aws_cloudfront_cache_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontCachePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontCachePolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource1Name, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "default_ttl", resourceName, "default_ttl"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "max_ttl", resourceName, "max_ttl"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "min_ttl", resourceName, "min_ttl"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.#", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookie_behavior", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookie_behavior"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookies.0.items.#", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookies.0.items.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.0.enable_accept_encoding_gzip", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.enable_accept_encoding_gzip"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.0.headers_config.0.header_behavior", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.headers_config.0.header_behavior"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.0.query_strings_config.0.query_string_behavior", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.query_strings_config.0.query_string_behavior"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "default_ttl", resourceName, "default_ttl"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDataSourceCachePolicy_managed(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_cache_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontCachePolicyDataSourceConfigManaged,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "658327ea-f89d-4fab-a63d-7e88639e58f6"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-CachingOptimized"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontCachePolicyDataSourceConfig(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontCachePolicyConfigItems(rName),
+		`
+data "aws_cloudfront_cache_policy" "by_id" {
+  id = aws_cloudfront_cache_policy.test.id
+}
+
+data "aws_cloudfront_cache_policy" "by_name" {
+  name = aws_cloudfront_cache_policy.test.name
+}
+`)
+}
+
+const testAccAWSCloudFrontCachePolicyDataSourceConfigManaged = `
+data "aws_cloudfront_cache_policy" "test" {
+  name = "Managed-CachingOptimized"
+}
+`
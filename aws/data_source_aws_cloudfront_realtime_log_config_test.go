@@ -0,0 +1,47 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSCloudFrontDataSourceRealtimeLogConfig_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	samplingRate := acctest.RandIntRange(1, 100)
+	dataSourceName := "data.aws_cloudfront_realtime_log_config.test"
+	resourceName := "aws_cloudfront_realtime_log_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontRealtimeLogConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontRealtimeLogConfigDataSourceConfig(rName, samplingRate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.#", resourceName, "endpoint.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.kinesis_stream_config.0.role_arn", resourceName, "endpoint.0.kinesis_stream_config.0.role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.kinesis_stream_config.0.stream_arn", resourceName, "endpoint.0.kinesis_stream_config.0.stream_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.stream_type", resourceName, "endpoint.0.stream_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fields.#", resourceName, "fields.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sampling_rate", resourceName, "sampling_rate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontRealtimeLogConfigDataSourceConfig(rName string, samplingRate int) string {
+	return composeConfig(
+		testAccAWSCloudFrontRealtimeLogConfigConfig(rName, samplingRate, 0, `["timestamp", "c-ip"]`),
+		`
+data "aws_cloudfront_realtime_log_config" "test" {
+  name = aws_cloudfront_realtime_log_config.test.name
+}
+`)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/directconnect/lister"
 )
 
 func dataSourceAwsDxGateway() *schema.Resource {
@@ -37,20 +38,18 @@ func dataSourceAwsDxGatewayRead(d *schema.ResourceData, meta interface{}) error
 	gateways := make([]*directconnect.Gateway, 0)
 	// DescribeDirectConnectGatewaysInput does not have a name parameter for filtering
 	input := &directconnect.DescribeDirectConnectGatewaysInput{}
-	for {
-		output, err := conn.DescribeDirectConnectGateways(input)
-		if err != nil {
-			return fmt.Errorf("error reading Direct Connect Gateway: %s", err)
-		}
-		for _, gateway := range output.DirectConnectGateways {
+	err := lister.DescribeDirectConnectGatewaysPages(conn, input, func(page *directconnect.DescribeDirectConnectGatewaysOutput, lastPage bool) bool {
+		for _, gateway := range page.DirectConnectGateways {
 			if aws.StringValue(gateway.DirectConnectGatewayName) == name {
 				gateways = append(gateways, gateway)
 			}
 		}
-		if output.NextToken == nil {
-			break
-		}
-		input.NextToken = output.NextToken
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Direct Connect Gateway: %s", err)
 	}
 
 	if len(gateways) == 0 {
@@ -59,17 +59,24 @@ func dataSourceAwsEbsSnapshotIdsRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Reading EBS Snapshot IDs: %s", params)
-	resp, err := conn.DescribeSnapshots(params)
+	var snapshots []*ec2.Snapshot
+
+	err := conn.DescribeSnapshotsPages(params, func(page *ec2.DescribeSnapshotsOutput, lastPage bool) bool {
+		snapshots = append(snapshots, page.Snapshots...)
+
+		return !lastPage
+	})
+
 	if err != nil {
 		return err
 	}
 
 	snapshotIds := make([]string, 0)
 
-	sort.Slice(resp.Snapshots, func(i, j int) bool {
-		return aws.TimeValue(resp.Snapshots[i].StartTime).Unix() > aws.TimeValue(resp.Snapshots[j].StartTime).Unix()
+	sort.Slice(snapshots, func(i, j int) bool {
+		return aws.TimeValue(snapshots[i].StartTime).Unix() > aws.TimeValue(snapshots[j].StartTime).Unix()
 	})
-	for _, snapshot := range resp.Snapshots {
+	for _, snapshot := range snapshots {
 		snapshotIds = append(snapshotIds, *snapshot.SnapshotId)
 	}
 
@@ -50,21 +50,24 @@ func dataSourceAwsEbsVolumesRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] DescribeVolumes %s\n", req)
-	resp, err := conn.DescribeVolumes(req)
+	volumes := make([]string, 0)
+
+	err := conn.DescribeVolumesPages(req, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		for _, volume := range page.Volumes {
+			volumes = append(volumes, *volume.VolumeId)
+		}
+
+		return !lastPage
+	})
+
 	if err != nil {
 		return fmt.Errorf("error describing EC2 Volumes: %w", err)
 	}
 
-	if resp == nil || len(resp.Volumes) == 0 {
+	if len(volumes) == 0 {
 		return errors.New("no matching volumes found")
 	}
 
-	volumes := make([]string, 0)
-
-	for _, volume := range resp.Volumes {
-		volumes = append(volumes, *volume.VolumeId)
-	}
-
 	d.SetId(meta.(*AWSClient).region)
 
 	if err := d.Set("ids", volumes); err != nil {
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSEksAddonDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceResourceName := "data.aws_eks_addon.test"
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonDataSourceConfig(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "addon_name", dataSourceResourceName, "addon_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "addon_version", dataSourceResourceName, "addon_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "created_at", dataSourceResourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_account_role_arn", dataSourceResourceName, "service_account_role_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "status", dataSourceResourceName, "status"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceResourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSEksAddonDataSourceConfig(rName, addonName string) string {
+	return composeConfig(testAccAWSEksAddonConfigAddonName(rName, addonName), `
+data "aws_eks_addon" "test" {
+  addon_name   = aws_eks_addon.test.addon_name
+  cluster_name = aws_eks_addon.test.cluster_name
+}
+`)
+}
@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/waiter"
+)
+
+func dataSourceAwsLakeFormationPermissions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLakeFormationPermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"catalog_resource": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+			},
+			"data_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues, // can be non-ARN, e.g. "IAM_ALLOWED_PRINCIPALS"
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							AtLeastOneOf: []string{
+								"table_with_columns.0.column_names",
+								"table_with_columns.0.wildcard",
+							},
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							AtLeastOneOf: []string{
+								"table_with_columns.0.column_names",
+								"table_with_columns.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		// Table with columns permissions cannot be listed directly, so the table is listed instead
+		Resource: expandLakeFormationPermissionsResource(d, true),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	tableType, columnNames, excludedColumnNames, columnWildcard := expandLakeFormationPermissionsTableType(d)
+
+	outputRaw, _, err := waiter.PermissionsStatus(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)()
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Permissions: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+
+	cleanPermissions, _ := outputRaw.([]*lakeformation.PrincipalResourcePermissions)
+
+	if len(cleanPermissions) == 0 {
+		d.Set("permissions", nil)
+		d.Set("permissions_with_grant_option", nil)
+		return nil
+	}
+
+	return setLakeFormationPermissionsData(d, cleanPermissions, tableType)
+}
@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSLakeFormationPermissionsDataSource_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":            testAccAWSLakeFormationPermissionsDataSource_basic,
+		"database":         testAccAWSLakeFormationPermissionsDataSource_database,
+		"tableWithColumns": testAccAWSLakeFormationPermissionsDataSource_tableWithColumns,
+		// if more tests are added, they should be serial (data catalog is account-shared resource)
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccAWSLakeFormationPermissionsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	dataSourceName := "data.aws_lakeformation_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "principal", dataSourceName, "principal"),
+					resource.TestCheckResourceAttrPair(resourceName, "permissions.#", dataSourceName, "permissions.#"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "permissions.*", lakeformation.PermissionCreateDatabase),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissionsDataSource_database(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	dataSourceName := "data.aws_lakeformation_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsDataSourceConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "principal", dataSourceName, "principal"),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", dataSourceName, "database.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.#", "3"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "permissions.*", lakeformation.PermissionAlter),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "permissions.*", lakeformation.PermissionCreateTable),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "permissions.*", lakeformation.PermissionDrop),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions_with_grant_option.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "permissions_with_grant_option.*", lakeformation.PermissionCreateTable),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissionsDataSource_tableWithColumns(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	dataSourceName := "data.aws_lakeformation_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(lakeformation.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsDataSourceConfig_tableWithColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "principal", dataSourceName, "principal"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_with_columns.0.name", dataSourceName, "table_with_columns.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "table_with_columns.0.column_names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "permissions.*", lakeformation.PermissionSelect),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissionsDataSourceConfig_basic(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfig_basic(rName),
+		`
+data "aws_lakeformation_permissions" "test" {
+  principal        = aws_lakeformation_permissions.test.principal
+  catalog_resource = true
+}
+`)
+}
+
+func testAccAWSLakeFormationPermissionsDataSourceConfig_database(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfig_database(rName),
+		`
+data "aws_lakeformation_permissions" "test" {
+  principal = aws_lakeformation_permissions.test.principal
+
+  database {
+    name = aws_lakeformation_permissions.test.database[0].name
+  }
+}
+`)
+}
+
+func testAccAWSLakeFormationPermissionsDataSourceConfig_tableWithColumns(rName string) string {
+	return composeConfig(
+		testAccAWSLakeFormationPermissionsConfig_tableWithColumns(rName),
+		`
+data "aws_lakeformation_permissions" "test" {
+  principal = aws_lakeformation_permissions.test.principal
+
+  table_with_columns {
+    database_name = aws_lakeformation_permissions.test.table_with_columns[0].database_name
+    name          = aws_lakeformation_permissions.test.table_with_columns[0].name
+    column_names  = aws_lakeformation_permissions.test.table_with_columns[0].column_names
+  }
+}
+`)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsLb() *schema.Resource {
@@ -151,6 +152,8 @@ func dataSourceAwsLb() *schema.Resource {
 
 func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elbv2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
 	lbArn := d.Get("arn").(string)
 	lbName := d.Get("name").(string)
 
@@ -172,5 +175,19 @@ func dataSourceAwsLbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(aws.StringValue(describeResp.LoadBalancers[0].LoadBalancerArn))
 
-	return flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0])
+	if err := flattenAwsLbResource(d, meta, describeResp.LoadBalancers[0]); err != nil {
+		return err
+	}
+
+	tags, err := keyvaluetags.Elbv2ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for LB (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
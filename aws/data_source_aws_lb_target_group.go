@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsLbTargetGroup() *schema.Resource {
@@ -156,6 +157,8 @@ func dataSourceAwsLbTargetGroup() *schema.Resource {
 
 func dataSourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
 	tgArn := d.Get("arn").(string)
 	tgName := d.Get("name").(string)
 
@@ -179,5 +182,19 @@ func dataSourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) er
 	targetGroup := describeResp.TargetGroups[0]
 
 	d.SetId(aws.StringValue(targetGroup.TargetGroupArn))
-	return flattenAwsLbTargetGroupResource(d, meta, targetGroup)
+	if err := flattenAwsLbTargetGroupResource(d, meta, targetGroup); err != nil {
+		return err
+	}
+
+	tags, err := keyvaluetags.Elbv2ListTags(elbconn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for LB Target Group (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
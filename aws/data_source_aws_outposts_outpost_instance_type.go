@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/outposts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/outposts/lister"
 )
 
 func dataSourceAwsOutpostsOutpostInstanceType() *schema.Resource {
@@ -44,28 +45,22 @@ func dataSourceAwsOutpostsOutpostInstanceTypeRead(d *schema.ResourceData, meta i
 	var outpostID string
 	var foundInstanceTypes []string
 
-	for {
-		output, err := conn.GetOutpostInstanceTypes(input)
-
-		if err != nil {
-			return fmt.Errorf("error getting Outpost Instance Types: %w", err)
-		}
-
-		if output == nil {
-			break
+	err := lister.GetOutpostInstanceTypesPages(conn, input, func(page *outposts.GetOutpostInstanceTypesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
-		outpostID = aws.StringValue(output.OutpostId)
+		outpostID = aws.StringValue(page.OutpostId)
 
-		for _, outputInstanceType := range output.InstanceTypes {
+		for _, outputInstanceType := range page.InstanceTypes {
 			foundInstanceTypes = append(foundInstanceTypes, aws.StringValue(outputInstanceType.InstanceType))
 		}
 
-		if aws.StringValue(output.NextToken) == "" {
-			break
-		}
+		return !lastPage
+	})
 
-		input.NextToken = output.NextToken
+	if err != nil {
+		return fmt.Errorf("error getting Outpost Instance Types: %w", err)
 	}
 
 	if len(foundInstanceTypes) == 0 {
@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsResourceGroupsTaggingAPIResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsResourceGroupsTaggingAPIResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"exclude_compliant_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"include_compliance_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resource_arn_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"resource_type_filters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tag_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 20,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 256),
+							},
+						},
+					},
+				},
+			},
+			"resource_tag_mapping_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliance_details": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"compliance_status": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"keys_with_noncompliant_values": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"non_compliant_keys": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsResourceGroupsTaggingAPIResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{}
+
+	if v, ok := d.GetOk("exclude_compliant_resources"); ok {
+		input.ExcludeCompliantResources = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("include_compliance_details"); ok {
+		input.IncludeComplianceDetails = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTypeFilters = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("tag_filter"); ok && len(v.([]interface{})) > 0 {
+		input.TagFilters = expandAwsResourceGroupsTaggingAPITagFilters(v.([]interface{}))
+	}
+
+	// The API does not support looking up resources by ARN,
+	// so any ARNs are used to filter the results.
+	var resourceARNs map[string]bool
+
+	if v, ok := d.GetOk("resource_arn_list"); ok && v.(*schema.Set).Len() > 0 {
+		resourceARNs = make(map[string]bool, v.(*schema.Set).Len())
+
+		for _, resourceARN := range v.(*schema.Set).List() {
+			resourceARNs[resourceARN.(string)] = true
+		}
+	}
+
+	var resourceTagMappings []*resourcegroupstaggingapi.ResourceTagMapping
+
+	err := conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, resourceTagMapping := range page.ResourceTagMappingList {
+			if resourceTagMapping == nil {
+				continue
+			}
+
+			if resourceARNs != nil && !resourceARNs[aws.StringValue(resourceTagMapping.ResourceARN)] {
+				continue
+			}
+
+			resourceTagMappings = append(resourceTagMappings, resourceTagMapping)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Resource Groups Tagging API Resources: %w", err)
+	}
+
+	if err := d.Set("resource_tag_mapping_list", flattenAwsResourceGroupsTaggingAPIResourceTagMappings(resourceTagMappings, ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting resource_tag_mapping_list: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	return nil
+}
+
+func expandAwsResourceGroupsTaggingAPITagFilters(l []interface{}) []*resourcegroupstaggingapi.TagFilter {
+	var tagFilters []*resourcegroupstaggingapi.TagFilter
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		tagFilter := &resourcegroupstaggingapi.TagFilter{
+			Key: aws.String(tfMap["key"].(string)),
+		}
+
+		if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+			tagFilter.Values = expandStringSet(v)
+		}
+
+		tagFilters = append(tagFilters, tagFilter)
+	}
+
+	return tagFilters
+}
+
+func flattenAwsResourceGroupsTaggingAPIResourceTagMappings(apiObjects []*resourcegroupstaggingapi.ResourceTagMapping, ignoreTagsConfig *keyvaluetags.IgnoreConfig) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"resource_arn": aws.StringValue(apiObject.ResourceARN),
+			"tags":         keyvaluetags.ResourcegroupstaggingapiKeyValueTags(apiObject.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map(),
+		}
+
+		if v := apiObject.ComplianceDetails; v != nil {
+			tfMap["compliance_details"] = []interface{}{
+				map[string]interface{}{
+					"compliance_status":             aws.BoolValue(v.ComplianceStatus),
+					"keys_with_noncompliant_values": aws.StringValueSlice(v.KeysWithNoncompliantValues),
+					"non_compliant_keys":            aws.StringValueSlice(v.NoncompliantKeys),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
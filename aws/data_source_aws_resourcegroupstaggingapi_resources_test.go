@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSResourceGroupsTaggingAPIResourcesDataSource_TagFilter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceGroupsTaggingAPIResourcesDataSourceConfigTagFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_tag_mapping_list.0.resource_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.0.tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSResourceGroupsTaggingAPIResourcesDataSource_ResourceArnList(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceGroupsTaggingAPIResourcesDataSourceConfigResourceArnList(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_tag_mapping_list.0.resource_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSResourceGroupsTaggingAPIResourcesDataSourceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSResourceGroupsTaggingAPIResourcesDataSourceConfigTagFilter(rName string) string {
+	return composeConfig(testAccAWSResourceGroupsTaggingAPIResourcesDataSourceConfigBase(rName), `
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  resource_type_filters = ["ec2:vpc"]
+
+  tag_filter {
+    key    = "Name"
+    values = [aws_vpc.test.tags["Name"]]
+  }
+}
+`)
+}
+
+func testAccAWSResourceGroupsTaggingAPIResourcesDataSourceConfigResourceArnList(rName string) string {
+	return composeConfig(testAccAWSResourceGroupsTaggingAPIResourcesDataSourceConfigBase(rName), `
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  resource_arn_list     = [aws_vpc.test.arn]
+  resource_type_filters = ["ec2:vpc"]
+}
+`)
+}
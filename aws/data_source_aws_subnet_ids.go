@@ -62,21 +62,24 @@ func dataSourceAwsSubnetIDsRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] DescribeSubnets %s\n", req)
-	resp, err := conn.DescribeSubnets(req)
+	subnets := make([]string, 0)
+
+	err := conn.DescribeSubnetsPages(req, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		for _, subnet := range page.Subnets {
+			subnets = append(subnets, *subnet.SubnetId)
+		}
+
+		return !lastPage
+	})
+
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.Subnets) == 0 {
+	if len(subnets) == 0 {
 		return fmt.Errorf("no matching subnet found for vpc with id %s", d.Get("vpc_id").(string))
 	}
 
-	subnets := make([]string, 0)
-
-	for _, subnet := range resp.Subnets {
-		subnets = append(subnets, *subnet.SubnetId)
-	}
-
 	d.SetId(d.Get("vpc_id").(string))
 	d.Set("ids", subnets)
 
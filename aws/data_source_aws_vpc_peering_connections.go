@@ -42,19 +42,21 @@ func dataSourceAwsVpcPeeringConnectionsRead(d *schema.ResourceData, meta interfa
 		req.Filters = nil
 	}
 
-	resp, err := conn.DescribeVpcPeeringConnections(req)
+	var ids []string
+	err := conn.DescribeVpcPeeringConnectionsPages(req, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
+		for _, pcx := range page.VpcPeeringConnections {
+			ids = append(ids, aws.StringValue(pcx.VpcPeeringConnectionId))
+		}
+
+		return !lastPage
+	})
 	if err != nil {
 		return err
 	}
-	if resp == nil || len(resp.VpcPeeringConnections) == 0 {
+	if len(ids) == 0 {
 		return fmt.Errorf("no matching VPC peering connections found")
 	}
 
-	var ids []string
-	for _, pcx := range resp.VpcPeeringConnections {
-		ids = append(ids, aws.StringValue(pcx.VpcPeeringConnectionId))
-	}
-
 	d.SetId(meta.(*AWSClient).region)
 
 	err = d.Set("ids", ids)
@@ -50,21 +50,24 @@ func dataSourceAwsVpcsRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] DescribeVpcs %s\n", req)
-	resp, err := conn.DescribeVpcs(req)
+	vpcs := make([]string, 0)
+
+	err := conn.DescribeVpcsPages(req, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		for _, vpc := range page.Vpcs {
+			vpcs = append(vpcs, aws.StringValue(vpc.VpcId))
+		}
+
+		return !lastPage
+	})
+
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.Vpcs) == 0 {
+	if len(vpcs) == 0 {
 		return fmt.Errorf("no matching VPC found")
 	}
 
-	vpcs := make([]string, 0)
-
-	for _, vpc := range resp.Vpcs {
-		vpcs = append(vpcs, aws.StringValue(vpc.VpcId))
-	}
-
 	d.SetId(meta.(*AWSClient).region)
 
 	if err := d.Set("ids", vpcs); err != nil {
@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafregional/lister"
 )
 
 func dataSourceAwsWafRegionalIpSet() *schema.Resource {
@@ -26,23 +27,20 @@ func dataSourceAWSWafRegionalIpSetRead(d *schema.ResourceData, meta interface{})
 	name := d.Get("name").(string)
 
 	ipsets := make([]*waf.IPSetSummary, 0)
-	// ListIPSetsInput does not have a name parameter for filtering or a paginator
+	// ListIPSetsInput does not have a name parameter for filtering
 	input := &waf.ListIPSetsInput{}
-	for {
-		output, err := conn.ListIPSets(input)
-		if err != nil {
-			return fmt.Errorf("Error reading WAF Regional IP sets: %s", err)
-		}
-		for _, ipset := range output.IPSets {
+	err := lister.ListIPSetsPages(conn, input, func(page *waf.ListIPSetsOutput, lastPage bool) bool {
+		for _, ipset := range page.IPSets {
 			if aws.StringValue(ipset.Name) == name {
 				ipsets = append(ipsets, ipset)
 			}
 		}
 
-		if output.NextMarker == nil {
-			break
-		}
-		input.NextMarker = output.NextMarker
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading WAF Regional IP sets: %s", err)
 	}
 
 	if len(ipsets) == 0 {
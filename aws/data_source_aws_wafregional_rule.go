@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafregional/lister"
 )
 
 func dataSourceAwsWafRegionalRule() *schema.Resource {
@@ -28,21 +29,18 @@ func dataSourceAwsWafRegionalRuleRead(d *schema.ResourceData, meta interface{})
 	rules := make([]*waf.RuleSummary, 0)
 	// ListRulesInput does not have a name parameter for filtering
 	input := &waf.ListRulesInput{}
-	for {
-		output, err := conn.ListRules(input)
-		if err != nil {
-			return fmt.Errorf("error reading WAF Rule: %s", err)
-		}
-		for _, rule := range output.Rules {
+	err := lister.ListRulesPages(conn, input, func(page *waf.ListRulesOutput, lastPage bool) bool {
+		for _, rule := range page.Rules {
 			if aws.StringValue(rule.Name) == name {
 				rules = append(rules, rule)
 			}
 		}
 
-		if output.NextMarker == nil {
-			break
-		}
-		input.NextMarker = output.NextMarker
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading WAF Rule: %s", err)
 	}
 
 	if len(rules) == 0 {
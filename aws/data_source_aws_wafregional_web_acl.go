@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafregional/lister"
 )
 
 func dataSourceAwsWafRegionalWebAcl() *schema.Resource {
@@ -28,21 +29,18 @@ func dataSourceAwsWafRegionalWebAclRead(d *schema.ResourceData, meta interface{}
 	acls := make([]*waf.WebACLSummary, 0)
 	// ListWebACLsInput does not have a name parameter for filtering
 	input := &waf.ListWebACLsInput{}
-	for {
-		output, err := conn.ListWebACLs(input)
-		if err != nil {
-			return fmt.Errorf("error reading web ACLs: %s", err)
-		}
-		for _, acl := range output.WebACLs {
+	err := lister.ListWebACLsPages(conn, input, func(page *waf.ListWebACLsOutput, lastPage bool) bool {
+		for _, acl := range page.WebACLs {
 			if aws.StringValue(acl.Name) == name {
 				acls = append(acls, acl)
 			}
 		}
 
-		if output.NextMarker == nil {
-			break
-		}
-		input.NextMarker = output.NextMarker
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading web ACLs: %s", err)
 	}
 
 	if len(acls) == 0 {
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafv2/lister"
 )
 
 func dataSourceAwsWafv2IPSet() *schema.Resource {
@@ -57,27 +58,19 @@ func dataSourceAwsWafv2IPSetRead(d *schema.ResourceData, meta interface{}) error
 		Limit: aws.Int64(100),
 	}
 
-	for {
-		resp, err := conn.ListIPSets(input)
-		if err != nil {
-			return fmt.Errorf("Error reading WAFv2 IPSets: %s", err)
-		}
-
-		if resp == nil || resp.IPSets == nil {
-			return fmt.Errorf("Error reading WAFv2 IPSets")
-		}
-
-		for _, ipSet := range resp.IPSets {
+	err := lister.ListIPSetsPages(conn, input, func(page *wafv2.ListIPSetsOutput, lastPage bool) bool {
+		for _, ipSet := range page.IPSets {
 			if ipSet != nil && aws.StringValue(ipSet.Name) == name {
 				foundIpSet = ipSet
-				break
+				return false
 			}
 		}
 
-		if resp.NextMarker == nil || foundIpSet != nil {
-			break
-		}
-		input.NextMarker = resp.NextMarker
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading WAFv2 IPSets: %s", err)
 	}
 
 	if foundIpSet == nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafv2/lister"
 )
 
 func dataSourceAwsWafv2RegexPatternSet() *schema.Resource {
@@ -60,27 +61,19 @@ func dataSourceAwsWafv2RegexPatternSetRead(d *schema.ResourceData, meta interfac
 		Limit: aws.Int64(100),
 	}
 
-	for {
-		resp, err := conn.ListRegexPatternSets(input)
-		if err != nil {
-			return fmt.Errorf("Error reading WAFv2 RegexPatternSets: %s", err)
-		}
-
-		if resp == nil || resp.RegexPatternSets == nil {
-			return fmt.Errorf("Error reading WAFv2 RegexPatternSets")
-		}
-
-		for _, regexPatternSet := range resp.RegexPatternSets {
+	err := lister.ListRegexPatternSetsPages(conn, input, func(page *wafv2.ListRegexPatternSetsOutput, lastPage bool) bool {
+		for _, regexPatternSet := range page.RegexPatternSets {
 			if regexPatternSet != nil && aws.StringValue(regexPatternSet.Name) == name {
 				foundRegexPatternSet = regexPatternSet
-				break
+				return false
 			}
 		}
 
-		if resp.NextMarker == nil || foundRegexPatternSet != nil {
-			break
-		}
-		input.NextMarker = resp.NextMarker
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading WAFv2 RegexPatternSets: %s", err)
 	}
 
 	if foundRegexPatternSet == nil {
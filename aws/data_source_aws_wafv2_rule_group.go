@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafv2/lister"
 )
 
 func dataSourceAwsWafv2RuleGroup() *schema.Resource {
@@ -48,27 +49,19 @@ func dataSourceAwsWafv2RuleGroupRead(d *schema.ResourceData, meta interface{}) e
 		Limit: aws.Int64(100),
 	}
 
-	for {
-		resp, err := conn.ListRuleGroups(input)
-		if err != nil {
-			return fmt.Errorf("Error reading WAFv2 RuleGroups: %s", err)
-		}
-
-		if resp == nil || resp.RuleGroups == nil {
-			return fmt.Errorf("Error reading WAFv2 RuleGroups")
-		}
-
-		for _, ruleGroup := range resp.RuleGroups {
+	err := lister.ListRuleGroupsPages(conn, input, func(page *wafv2.ListRuleGroupsOutput, lastPage bool) bool {
+		for _, ruleGroup := range page.RuleGroups {
 			if aws.StringValue(ruleGroup.Name) == name {
 				foundRuleGroup = ruleGroup
-				break
+				return false
 			}
 		}
 
-		if resp.NextMarker == nil || foundRuleGroup != nil {
-			break
-		}
-		input.NextMarker = resp.NextMarker
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading WAFv2 RuleGroups: %s", err)
 	}
 
 	if foundRuleGroup == nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafv2/lister"
 )
 
 func dataSourceAwsWafv2WebACL() *schema.Resource {
@@ -48,27 +49,19 @@ func dataSourceAwsWafv2WebACLRead(d *schema.ResourceData, meta interface{}) erro
 		Limit: aws.Int64(100),
 	}
 
-	for {
-		resp, err := conn.ListWebACLs(input)
-		if err != nil {
-			return fmt.Errorf("Error reading WAFv2 WebACLs: %s", err)
-		}
-
-		if resp == nil || resp.WebACLs == nil {
-			return fmt.Errorf("Error reading WAFv2 WebACLs")
-		}
-
-		for _, webACL := range resp.WebACLs {
+	err := lister.ListWebACLsPages(conn, input, func(page *wafv2.ListWebACLsOutput, lastPage bool) bool {
+		for _, webACL := range page.WebACLs {
 			if aws.StringValue(webACL.Name) == name {
 				foundWebACL = webACL
-				break
+				return false
 			}
 		}
 
-		if resp.NextMarker == nil || foundWebACL != nil {
-			break
-		}
-		input.NextMarker = resp.NextMarker
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error reading WAFv2 WebACLs: %s", err)
 	}
 
 	if foundWebACL == nil {
@@ -0,0 +1,153 @@
+package assumerole
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+)
+
+// Role contains settings for a single sts:AssumeRole hop.
+type Role struct {
+	ARN               string
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// WebIdentity contains settings for sts:AssumeRoleWithWebIdentity using an
+// OpenID Connect token read from a file.
+type WebIdentity struct {
+	ARN             string
+	DurationSeconds int
+	PolicyARNs      []string
+	SessionName     string
+	TokenFile       string
+}
+
+// Config contains an optional web identity role followed by an ordered list of
+// roles, each assumed using the credentials of the previous one.
+type Config struct {
+	WebIdentity *WebIdentity
+	Roles       []*Role
+}
+
+// Credentials returns credentials for the final role in the chain.
+// The first role is assumed using the web identity token if configured, otherwise
+// using creds. Each subsequent role is assumed using the credentials of the previous
+// one, so refreshing the returned credentials refreshes the whole chain as needed.
+// STS clients are created from p with cfgs applied.
+func (c *Config) Credentials(p client.ConfigProvider, creds *credentials.Credentials, cfgs ...*aws.Config) *credentials.Credentials {
+	if c.WebIdentity != nil {
+		creds = credentials.NewCredentials(c.WebIdentity.provider(sts.New(p, cfgs...)))
+	}
+
+	for _, role := range c.Roles {
+		stsCfgs := append(append([]*aws.Config{}, cfgs...), &aws.Config{Credentials: creds})
+		creds = credentials.NewCredentials(role.provider(sts.New(p, stsCfgs...)))
+	}
+
+	return creds
+}
+
+// LastRoleARN returns the ARN of the final role in the chain, or "" if no roles are configured.
+func (c *Config) LastRoleARN() string {
+	if n := len(c.Roles); n > 0 {
+		return c.Roles[n-1].ARN
+	}
+
+	if c.WebIdentity != nil {
+		return c.WebIdentity.ARN
+	}
+
+	return ""
+}
+
+// AccountIDAndPartition returns the AWS account ID and partition of the final role in the chain.
+func (c *Config) AccountIDAndPartition() (string, string, error) {
+	roleARN := c.LastRoleARN()
+
+	if roleARN == "" {
+		return "", "", nil
+	}
+
+	v, err := arn.Parse(roleARN)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing role ARN (%s): %w", roleARN, err)
+	}
+
+	return v.AccountID, v.Partition, nil
+}
+
+func (r *Role) provider(svc stscreds.AssumeRoler) *stscreds.AssumeRoleProvider {
+	p := &stscreds.AssumeRoleProvider{
+		Client:          svc,
+		RoleARN:         r.ARN,
+		RoleSessionName: r.SessionName,
+		Duration:        stscreds.DefaultDuration,
+	}
+
+	if p.RoleSessionName == "" {
+		p.RoleSessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+
+	if r.DurationSeconds > 0 {
+		p.Duration = time.Duration(r.DurationSeconds) * time.Second
+	}
+
+	if r.ExternalID != "" {
+		p.ExternalID = aws.String(r.ExternalID)
+	}
+
+	if r.Policy != "" {
+		p.Policy = aws.String(r.Policy)
+	}
+
+	p.PolicyArns = policyDescriptors(r.PolicyARNs)
+
+	for k, v := range r.Tags {
+		p.Tags = append(p.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	p.TransitiveTagKeys = aws.StringSlice(r.TransitiveTagKeys)
+
+	return p
+}
+
+func (w *WebIdentity) provider(svc stsiface.STSAPI) *stscreds.WebIdentityRoleProvider {
+	p := stscreds.NewWebIdentityRoleProvider(svc, w.ARN, w.SessionName, w.TokenFile)
+
+	if w.DurationSeconds > 0 {
+		p.Duration = time.Duration(w.DurationSeconds) * time.Second
+	}
+
+	p.PolicyArns = policyDescriptors(w.PolicyARNs)
+
+	return p
+}
+
+func policyDescriptors(policyARNs []string) []*sts.PolicyDescriptorType {
+	var descriptors []*sts.PolicyDescriptorType
+
+	for _, policyARN := range policyARNs {
+		descriptors = append(descriptors, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	return descriptors
+}
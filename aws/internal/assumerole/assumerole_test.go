@@ -0,0 +1,171 @@
+package assumerole
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+var credentialRegexp = regexp.MustCompile(`Credential=([^/]+)/`)
+
+// stsServer returns a mock STS endpoint issuing credentials whose access key ID
+// records the access key ID of the caller and the session name of the role assumed.
+func stsServer(t *testing.T, token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		action := r.Form.Get("Action")
+		caller := "anonymous"
+
+		if m := credentialRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+			caller = m[1]
+		}
+
+		if action == "AssumeRoleWithWebIdentity" && r.Form.Get("WebIdentityToken") != token {
+			t.Errorf("unexpected web identity token: %s", r.Form.Get("WebIdentityToken"))
+		}
+
+		accessKeyID := fmt.Sprintf("%s>%s", caller, r.Form.Get("RoleSessionName"))
+
+		fmt.Fprintf(w, `<%[1]sResponse><%[1]sResult><Credentials>
+<AccessKeyId>%[2]s</AccessKeyId>
+<SecretAccessKey>secret</SecretAccessKey>
+<SessionToken>token</SessionToken>
+<Expiration>2100-01-01T00:00:00Z</Expiration>
+</Credentials></%[1]sResult></%[1]sResponse>`, action, accessKeyID)
+	}))
+}
+
+func TestConfigCredentials(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("oidc-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	server := stsServer(t, "oidc-token")
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint: aws.String(server.URL),
+		Region:   aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hub := &Role{ARN: "arn:aws:iam::111111111111:role/hub", SessionName: "hub"}                //lintignore:AWSAT005
+	workload := &Role{ARN: "arn:aws:iam::222222222222:role/workload", SessionName: "workload"} //lintignore:AWSAT005
+
+	testCases := []struct {
+		Name                string
+		Config              *Config
+		ExpectedAccessKeyID string
+		ExpectedAccountID   string
+	}{
+		{
+			Name:                "no roles",
+			Config:              &Config{},
+			ExpectedAccessKeyID: "base",
+		},
+		{
+			Name: "single role",
+			Config: &Config{
+				Roles: []*Role{hub},
+			},
+			ExpectedAccessKeyID: "base>hub",
+			ExpectedAccountID:   "111111111111",
+		},
+		{
+			Name: "role chain",
+			Config: &Config{
+				Roles: []*Role{hub, workload},
+			},
+			ExpectedAccessKeyID: "base>hub>workload",
+			ExpectedAccountID:   "222222222222",
+		},
+		{
+			Name: "web identity",
+			Config: &Config{
+				WebIdentity: &WebIdentity{
+					ARN:         "arn:aws:iam::333333333333:role/ci", //lintignore:AWSAT005
+					SessionName: "ci",
+					TokenFile:   tokenFile,
+				},
+			},
+			ExpectedAccessKeyID: "anonymous>ci",
+			ExpectedAccountID:   "333333333333",
+		},
+		{
+			Name: "web identity and role chain",
+			Config: &Config{
+				WebIdentity: &WebIdentity{
+					ARN:         "arn:aws:iam::333333333333:role/ci", //lintignore:AWSAT005
+					SessionName: "ci",
+					TokenFile:   tokenFile,
+				},
+				Roles: []*Role{hub, workload},
+			},
+			ExpectedAccessKeyID: "anonymous>ci>hub>workload",
+			ExpectedAccountID:   "222222222222",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			base := credentials.NewStaticCredentials("base", "secret", "")
+
+			value, err := testCase.Config.Credentials(sess, base).Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := value.AccessKeyID, testCase.ExpectedAccessKeyID; got != expected {
+				t.Errorf("got access key ID %s, expected %s", got, expected)
+			}
+
+			accountID, _, err := testCase.Config.AccountIDAndPartition()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := accountID, testCase.ExpectedAccountID; got != expected {
+				t.Errorf("got account ID %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigCredentialsTokenFileNotFound(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &Config{
+		WebIdentity: &WebIdentity{
+			ARN:       "arn:aws:iam::333333333333:role/ci", //lintignore:AWSAT005
+			TokenFile: filepath.Join(os.TempDir(), "tf-acc-test-missing-token"),
+		},
+	}
+
+	if _, err := config.Credentials(sess, nil).Get(); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
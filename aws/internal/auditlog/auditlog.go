@@ -0,0 +1,128 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	handlerNameAuditLog = "terraform-provider-aws.auditlog.AuditLog"
+)
+
+// Config contains settings for writing an audit log of AWS API calls.
+type Config struct {
+	// Path is the file audit log entries are appended to.
+	Path string
+
+	// IncludeParameters adds the API call input parameters, with any sensitive
+	// fields redacted, to each audit log entry.
+	IncludeParameters bool
+
+	// Callers attributes API calls to the Terraform resource type making them.
+	Callers *Callers
+}
+
+// Entry is a single audit log entry, written as one line of JSON per API call.
+type Entry struct {
+	Time           time.Time   `json:"time"`
+	Service        string      `json:"service"`
+	Operation      string      `json:"operation"`
+	Region         string      `json:"region,omitempty"`
+	RequestID      string      `json:"request_id,omitempty"`
+	LatencyMS      int64       `json:"latency_ms"`
+	RetryCount     int         `json:"retry_count"`
+	HTTPStatusCode int         `json:"http_status_code,omitempty"`
+	ErrorCode      string      `json:"error_code,omitempty"`
+	ResourceType   string      `json:"resource_type,omitempty"`
+	Parameters     interface{} `json:"parameters,omitempty"`
+}
+
+// ConfigureSession opens the audit log file and installs a request handler on the
+// session writing an entry for each completed API call. Service clients subsequently
+// created from copies of the session share the audit log.
+func (c *Config) ConfigureSession(sess *session.Session) error {
+	if c == nil || sess == nil {
+		return nil
+	}
+
+	// The file remains open for the lifetime of the provider process.
+	f, err := os.OpenFile(c.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return fmt.Errorf("error opening audit log file (%s): %w", c.Path, err)
+	}
+
+	c.configureSession(sess, &writer{w: f})
+
+	return nil
+}
+
+func (c *Config) configureSession(sess *session.Session, w *writer) {
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: handlerNameAuditLog,
+		Fn: func(r *request.Request) {
+			w.write(c.entry(r))
+		},
+	})
+}
+
+// entry returns the audit log entry for a completed request.
+func (c *Config) entry(r *request.Request) *Entry {
+	entry := &Entry{
+		Time:       r.Time.UTC(),
+		Service:    r.ClientInfo.ServiceName,
+		Operation:  r.Operation.Name,
+		Region:     aws.StringValue(r.Config.Region),
+		RequestID:  r.RequestID,
+		LatencyMS:  time.Since(r.Time).Milliseconds(),
+		RetryCount: r.RetryCount,
+	}
+
+	if r.HTTPResponse != nil {
+		entry.HTTPStatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if err, ok := r.Error.(awserr.Error); ok {
+		entry.ErrorCode = err.Code()
+	}
+
+	if c.Callers != nil {
+		entry.ResourceType = c.Callers.ResourceType()
+	}
+
+	if c.IncludeParameters {
+		entry.Parameters = Redact(r.Params)
+	}
+
+	return entry
+}
+
+// writer serializes audit log entries to an underlying writer.
+type writer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (w *writer) write(entry *Entry) {
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Each entry is written with a single call so that entries from providers
+	// sharing the file are not interleaved.
+	_, _ = w.w.Write(append(b, '\n'))
+}
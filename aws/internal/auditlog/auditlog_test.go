@@ -0,0 +1,251 @@
+package auditlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+func testSession(t *testing.T, url string) *session.Session {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(url),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sess
+}
+
+func TestConfigConfigureSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Amzn-Requestid", "request-1")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>request-1</RequestId></ErrorResponse>`)
+	}))
+	defer server.Close()
+
+	callers := NewCallers()
+	callers.Register("aws_iam_user_login_profile", testCreateLoginProfile)
+
+	config := &Config{
+		IncludeParameters: true,
+		Callers:           callers,
+	}
+
+	var buf bytes.Buffer
+	sess := testSession(t, server.URL)
+	config.configureSession(sess, &writer{w: &buf})
+
+	if err := testCreateLoginProfile(iam.New(sess)); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+	if len(lines) != 1 {
+		t.Fatalf("got %d audit log entries, expected 1", len(lines))
+	}
+
+	var got map[string]interface{}
+
+	if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+		t.Fatalf("error parsing audit log entry: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"service":          "iam",
+		"operation":        "CreateLoginProfile",
+		"region":           "us-east-1", //lintignore:AWSAT003
+		"request_id":       "request-1",
+		"retry_count":      float64(0),
+		"http_status_code": float64(http.StatusBadRequest),
+		"error_code":       "Throttling",
+		"resource_type":    "aws_iam_user_login_profile",
+		"parameters": map[string]interface{}{
+			"Password": RedactedValue,
+			"UserName": "test",
+		},
+	}
+
+	for k, v := range expected {
+		if !reflect.DeepEqual(got[k], v) {
+			t.Errorf("got %s %#v, expected %#v", k, got[k], v)
+		}
+	}
+
+	if _, ok := got["latency_ms"]; !ok {
+		t.Error("expected latency_ms")
+	}
+
+	if strings.Contains(lines[0], "hunter2") {
+		t.Errorf("audit log entry contains sensitive value: %s", lines[0])
+	}
+}
+
+func TestConfigConfigureSessionFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<GetUserResponse><GetUserResult><User><UserName>test</UserName></User></GetUserResult></GetUserResponse>`)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Path: filepath.Join(t.TempDir(), "audit.log"),
+	}
+
+	sess := testSession(t, server.URL)
+
+	if err := config.ConfigureSession(sess); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conn := iam.New(sess)
+
+	for i := 0; i < 2; i++ {
+		if _, err := conn.GetUser(&iam.GetUserInput{UserName: aws.String("test")}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	b, err := ioutil.ReadFile(config.Path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+
+	if len(lines) != 2 {
+		t.Fatalf("got %d audit log entries, expected 2", len(lines))
+	}
+
+	for _, line := range lines {
+		var got Entry
+
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatalf("error parsing audit log entry: %s", err)
+		}
+
+		if got.Operation != "GetUser" || got.ErrorCode != "" || got.Parameters != nil || got.ResourceType != "" {
+			t.Errorf("unexpected audit log entry: %s", line)
+		}
+	}
+}
+
+func TestConfigConfigureSessionNil(t *testing.T) {
+	var config *Config
+
+	if err := config.ConfigureSession(testSession(t, "http://localhost")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	input := &iam.CreateAccessKeyOutput{
+		AccessKey: &iam.AccessKey{
+			AccessKeyId:     aws.String("AKID"),
+			CreateDate:      aws.Time(now),
+			SecretAccessKey: aws.String("SECRET"),
+		},
+	}
+
+	got := Redact(input)
+	expected := map[string]interface{}{
+		"AccessKey": map[string]interface{}{
+			"AccessKeyId":     "AKID",
+			"CreateDate":      now,
+			"SecretAccessKey": RedactedValue,
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}
+
+func TestRedactCollections(t *testing.T) {
+	type shape struct {
+		_ struct{} `type:"structure"`
+
+		Blob     []byte
+		List     []*string
+		Map      map[string]*string
+		Reader   *strings.Reader
+		Secret   map[string]*string `sensitive:"true"`
+		Unset    *string
+		internal string
+	}
+
+	got := Redact(&shape{
+		Blob:     []byte("data"),
+		List:     aws.StringSlice([]string{"a", "b"}),
+		Map:      aws.StringMap(map[string]string{"k": "v"}),
+		Reader:   strings.NewReader("payload"),
+		Secret:   aws.StringMap(map[string]string{"k": "v"}),
+		internal: "internal",
+	})
+	expected := map[string]interface{}{
+		"Blob":   "(4 bytes)",
+		"List":   []interface{}{"a", "b"},
+		"Map":    map[string]interface{}{"k": "v"},
+		"Reader": "(*strings.Reader)",
+		"Secret": RedactedValue,
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}
+
+func TestCallersResourceType(t *testing.T) {
+	callers := NewCallers()
+	callers.Register("aws_test", testCallerResourceType, nil)
+	callers.Register("aws_test_alias", testCallerResourceType)
+	callers.Register("aws_test", testCallerResourceType)
+
+	if got, expected := testCallerResourceType(callers), "aws_test,aws_test_alias"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if got, expected := callers.ResourceType(), ""; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	var nilCallers *Callers
+
+	if got, expected := nilCallers.ResourceType(), ""; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
+
+func testCallerResourceType(callers *Callers) string {
+	return callers.ResourceType()
+}
+
+func testCreateLoginProfile(conn *iam.IAM) error {
+	_, err := conn.CreateLoginProfile(&iam.CreateLoginProfileInput{
+		Password: aws.String("hunter2"),
+		UserName: aws.String("test"),
+	})
+
+	return err
+}
@@ -0,0 +1,93 @@
+package auditlog
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxCallerFrames is the maximum number of stack frames searched for a registered function.
+	maxCallerFrames = 64
+)
+
+// Callers maps the functions implementing Terraform resources and data sources,
+// such as their Create and Read functions, to the resource type.
+// Terraform does not provide the resource address to the provider, so API calls
+// are attributed to the resource type whose function is on the call stack.
+type Callers struct {
+	resourceTypes map[string][]string
+}
+
+// NewCallers returns an empty set of functions.
+func NewCallers() *Callers {
+	return &Callers{
+		resourceTypes: make(map[string][]string),
+	}
+}
+
+// Register adds the functions of a resource type. Nil functions are ignored.
+// Functions registered for more than one resource type are attributed to all of them.
+func (c *Callers) Register(resourceType string, fns ...interface{}) {
+	for _, fn := range fns {
+		name := functionName(fn)
+
+		if name == "" {
+			continue
+		}
+
+		resourceTypes := c.resourceTypes[name]
+
+		if i := sort.SearchStrings(resourceTypes, resourceType); i < len(resourceTypes) && resourceTypes[i] == resourceType {
+			continue
+		}
+
+		resourceTypes = append(resourceTypes, resourceType)
+		sort.Strings(resourceTypes)
+		c.resourceTypes[name] = resourceTypes
+	}
+}
+
+// ResourceType returns the resource type of the innermost registered function on the
+// call stack, or "" if there is none. This is the case for API calls made from goroutines
+// started by a resource, for example while waiting for a state change.
+func (c *Callers) ResourceType() string {
+	if c == nil || len(c.resourceTypes) == 0 {
+		return ""
+	}
+
+	pcs := make([]uintptr, maxCallerFrames)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+
+		if resourceTypes, ok := c.resourceTypes[frame.Function]; ok {
+			return strings.Join(resourceTypes, ",")
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return ""
+}
+
+// functionName returns the fully qualified name of a function, or "" for nil or non-function values.
+func functionName(fn interface{}) string {
+	v := reflect.ValueOf(fn)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	f := runtime.FuncForPC(v.Pointer())
+
+	if f == nil {
+		return ""
+	}
+
+	return f.Name()
+}
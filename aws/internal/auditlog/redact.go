@@ -0,0 +1,102 @@
+package auditlog
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+)
+
+const (
+	// RedactedValue replaces the value of sensitive fields.
+	RedactedValue = "(sensitive)"
+)
+
+var (
+	readerType = reflect.TypeOf((*io.Reader)(nil)).Elem()
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// Redact returns a JSON-serializable copy of an AWS SDK API input or output shape
+// with the values of fields tagged as sensitive replaced by RedactedValue.
+// Unset fields are omitted and streaming payloads are summarized.
+func Redact(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	return redactValue(reflect.ValueOf(v))
+}
+
+func redactValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Implements(readerType) {
+			return fmt.Sprintf("(%s)", v.Type())
+		}
+
+		return redactValue(v.Elem())
+
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface()
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if field.PkgPath != "" || field.Name == "_" {
+				continue
+			}
+
+			fieldValue := v.Field(i)
+
+			if fieldValue.IsZero() {
+				continue
+			}
+
+			if field.Tag.Get("sensitive") == "true" {
+				m[field.Name] = RedactedValue
+				continue
+			}
+
+			m[field.Name] = redactValue(fieldValue)
+		}
+
+		return m
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("(%d bytes)", v.Len())
+		}
+
+		l := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			l[i] = redactValue(v.Index(i))
+		}
+
+		return l
+
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = redactValue(iter.Value())
+		}
+
+		return m
+
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	}
+
+	return v.Interface()
+}
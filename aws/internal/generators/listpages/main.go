@@ -8,29 +8,33 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
-	"html/template"
 	"io/ioutil"
 	"log"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
+	"text/template"
 
 	"golang.org/x/tools/go/packages"
 )
 
 const (
-	outputName = "list_pages_gen.go"
+	defaultOutputName = "list_pages_gen.go"
 )
 
 var (
-	functionNames = flag.String("function", "", "comma-separated list of API List functions; required")
-	paginatorName = flag.String("paginator", "NextToken", "name of the pagination token field")
-	packageName   = flag.String("package", "", "override package name for generated code")
+	functionNames   = flag.String("function", "", "comma-separated list of API List functions, each optionally followed by :<result-key-path>; required")
+	paginatorName   = flag.String("paginator", "NextToken", "name of the pagination token field")
+	inputPaginator  = flag.String("input-paginator", "", "name of the pagination token field in the input (default: value of -paginator)")
+	outputPaginator = flag.String("output-paginator", "", "path of the pagination token field in the output, e.g. Page.NextToken (default: value of -paginator)")
+	outputName      = flag.String("output", defaultOutputName, "name of the generated file")
+	packageName     = flag.String("package", "", "override package name for generated code")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\tmain.go [flags] -function <function-name>[,<function-name>] <source-package>\n\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] -function <function-name>[:<result-key-path>][,<function-name>[:<result-key-path>]] <source-package>\n\n")
 	fmt.Fprintf(os.Stderr, "\tDestination package is read from the environment variable $GOPACKAGE by default. Override it with the flag -package.\n\n")
 	fmt.Fprintf(os.Stderr, "Flags:\n")
 	flag.PrintDefaults()
@@ -54,25 +58,46 @@ func main() {
 	functions := strings.Split(*functionNames, ",")
 	sort.Strings(functions)
 
+	if *inputPaginator == "" {
+		*inputPaginator = *paginatorName
+	}
+	if *outputPaginator == "" {
+		*outputPaginator = *paginatorName
+	}
+	if strings.Contains(*inputPaginator, ".") {
+		log.Fatalf("input pagination token field must be a top-level field: %s", *inputPaginator)
+	}
+
 	g := Generator{
-		paginator: *paginatorName,
-		tmpl:      template.Must(template.New("function").Parse(functionTemplate)),
+		inputPaginator:  *inputPaginator,
+		outputPaginator: *outputPaginator,
+		imports:         map[string]string{},
+		tmpl:            template.Must(template.New("function").Parse(functionTemplate)),
 	}
 	g.parsePackage(sourcePackage)
 
+	for _, function := range functions {
+		functionName, resultKey := function, ""
+		if i := strings.Index(function, ":"); i != -1 {
+			functionName, resultKey = function[:i], function[i+1:]
+		}
+		g.generateFunction(functionName, resultKey)
+	}
+
+	body := g.buf
+	g.buf = bytes.Buffer{}
+
 	g.printHeader(HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: destinationPackage,
 		SourcePackage:      sourcePackage,
+		Imports:            g.sortedImports(sourcePackage),
 	})
-
-	for _, functionName := range functions {
-		g.generateFunction(functionName)
-	}
+	g.buf.Write(body.Bytes())
 
 	src := g.format()
 
-	err := ioutil.WriteFile(outputName, src, 0644)
+	err := ioutil.WriteFile(*outputName, src, 0644)
 	if err != nil {
 		log.Fatalf("error writing output: %s", err)
 	}
@@ -82,13 +107,18 @@ type HeaderInfo struct {
 	Parameters         string
 	DestinationPackage string
 	SourcePackage      string
+	Imports            []string
 }
 
 type Generator struct {
-	buf       bytes.Buffer
-	pkg       *Package
-	tmpl      *template.Template
-	paginator string
+	buf             bytes.Buffer
+	pkg             *Package
+	tmpl            *template.Template
+	inputPaginator  string
+	outputPaginator string
+
+	// imports holds additional packages referenced by the generated functions, keyed by package name.
+	imports map[string]string
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
@@ -112,6 +142,20 @@ func (g *Generator) printHeader(headerInfo HeaderInfo) {
 	}
 }
 
+func (g *Generator) sortedImports(sourcePackage string) []string {
+	var imports []string
+
+	for _, path := range g.imports {
+		if path != sourcePackage {
+			imports = append(imports, path)
+		}
+	}
+
+	sort.Strings(imports)
+
+	return imports
+}
+
 func (g *Generator) parsePackage(sourcePackage string) {
 	cfg := &packages.Config{
 		Mode: packages.NeedName | packages.NeedSyntax,
@@ -144,39 +188,33 @@ type FuncSpec struct {
 	RecvType   string
 	ParamType  string
 	ResultType string
-	Paginator  string
-}
 
-func (g *Generator) generateFunction(functionName string) {
-	var function *ast.FuncDecl
+	InputPaginator  string
+	OutputPaginator string
 
-	// TODO: check if a Pages() function has been defined
-	for _, file := range g.pkg.files {
-		if file.file != nil {
-			for _, decl := range file.file.Decls {
-				if funcDecl, ok := decl.(*ast.FuncDecl); ok {
-					if funcDecl.Name.Name == functionName {
-						function = funcDecl
-						break
-					}
-				}
-			}
-			if function != nil {
-				break
-			}
-		}
-	}
+	// LastPage is an expression evaluating to true if `output` is the last page.
+	LastPage string
+}
+
+func (g *Generator) generateFunction(functionName, resultKey string) {
+	function, file := g.findFunction(functionName)
 
 	if function == nil {
 		log.Fatalf("function \"%s\" not found", functionName)
 	}
 
+	if pages, _ := g.findFunction(functionName + "Pages"); pages != nil {
+		log.Fatalf("function \"%s\" already has a Pages variant", functionName)
+	}
+
 	funcSpec := FuncSpec{
-		Name:       function.Name.Name,
-		RecvType:   g.expandTypeField(function.Recv),
-		ParamType:  g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
-		ResultType: g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
-		Paginator:  g.paginator,
+		Name:            function.Name.Name,
+		RecvType:        g.expandTypeField(file, function.Recv),
+		ParamType:       g.expandTypeField(file, function.Type.Params),  // Assumes there is a single input parameter
+		ResultType:      g.expandTypeField(file, function.Type.Results), // Assumes we can take the first return parameter
+		InputPaginator:  g.inputPaginator,
+		OutputPaginator: g.outputPaginator,
+		LastPage:        lastPageExpr("output", g.outputPaginator, resultKey),
 	}
 
 	err := g.tmpl.Execute(&g.buf, funcSpec)
@@ -185,25 +223,107 @@ func (g *Generator) generateFunction(functionName string) {
 	}
 }
 
-func (g *Generator) expandTypeField(field *ast.FieldList) string {
+func (g *Generator) findFunction(functionName string) (*ast.FuncDecl, *ast.File) {
+	for _, file := range g.pkg.files {
+		if file.file == nil {
+			continue
+		}
+
+		for _, decl := range file.file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv != nil {
+				if funcDecl.Name.Name == functionName {
+					return funcDecl, file.file
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (g *Generator) expandTypeField(file *ast.File, field *ast.FieldList) string {
 	typeValue := field.List[0].Type
 	if star, ok := typeValue.(*ast.StarExpr); ok {
-		return fmt.Sprintf("*%s", g.expandTypeExpr(star.X))
+		return fmt.Sprintf("*%s", g.expandTypeExpr(file, star.X))
 	}
 
 	log.Fatalf("Unexpected type expression: (%[1]T) %[1]v", typeValue)
 	return ""
 }
 
-func (g *Generator) expandTypeExpr(expr ast.Expr) string {
-	if ident, ok := expr.(*ast.Ident); ok {
-		return fmt.Sprintf("%s.%s", g.pkg.name, ident.Name)
+func (g *Generator) expandTypeExpr(file *ast.File, expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return fmt.Sprintf("%s.%s", g.pkg.name, v.Name)
+
+	case *ast.SelectorExpr:
+		// A type from another package, e.g. the WAF Regional API uses the WAF types.
+		if ident, ok := v.X.(*ast.Ident); ok {
+			g.addImport(file, ident.Name)
+			return fmt.Sprintf("%s.%s", ident.Name, v.Sel.Name)
+		}
 	}
 
 	log.Fatalf("Unexpected expression: (%[1]T) %[1]v", expr)
 	return ""
 }
 
+func (g *Generator) addImport(file *ast.File, name string) {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			log.Fatalf("error reading import %s: %s", spec.Path.Value, err)
+		}
+
+		if spec.Name != nil {
+			if spec.Name.Name == name {
+				log.Fatalf("unsupported named import %s %q", name, path)
+			}
+			continue
+		}
+
+		if path[strings.LastIndex(path, "/")+1:] == name {
+			g.imports[name] = path
+			return
+		}
+	}
+
+	log.Fatalf("import for package \"%s\" not found", name)
+}
+
+// lastPageExpr returns an expression evaluating to true if the output is the last page.
+// The output pagination token and the optional result key may be paths to nested fields.
+// If a result key is specified, a page with no results is also treated as the last page,
+// as some APIs return a pagination token even when there are no further results.
+func lastPageExpr(output, outputPaginator, resultKey string) string {
+	conditions := []string{nilGuardedExpr(output, outputPaginator, func(v string) string {
+		return fmt.Sprintf(`aws.StringValue(%s) == ""`, v)
+	})}
+
+	if resultKey != "" {
+		conditions = append(conditions, nilGuardedExpr(output, resultKey, func(v string) string {
+			return fmt.Sprintf(`len(%s) == 0`, v)
+		}))
+	}
+
+	return strings.Join(conditions, " || ")
+}
+
+func nilGuardedExpr(root, path string, f func(string) string) string {
+	fields := strings.Split(path, ".")
+	var conditions []string
+
+	v := root
+	for _, field := range fields[:len(fields)-1] {
+		v = fmt.Sprintf("%s.%s", v, field)
+		conditions = append(conditions, fmt.Sprintf("%s == nil", v))
+	}
+
+	conditions = append(conditions, f(fmt.Sprintf("%s.%s", v, fields[len(fields)-1])))
+
+	return strings.Join(conditions, " || ")
+}
+
 const headerTemplate = `// Code generated by "aws/internal/generators/listpages/main.go {{ .Parameters }}"; DO NOT EDIT.
 
 package {{ .DestinationPackage }}
@@ -213,6 +333,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"{{ .SourcePackage }}"
+{{- range .Imports }}
+	"{{ . }}"
+{{- end }}
 )
 `
 
@@ -229,12 +352,12 @@ func {{ .Name }}PagesWithContext(ctx context.Context, conn {{ .RecvType }}, inpu
 			return err
 		}
 
-		lastPage := aws.StringValue(output.{{ .Paginator }}) == ""
+		lastPage := {{ .LastPage }}
 		if !fn(output, lastPage) || lastPage {
 			break
 		}
 
-		input.{{ .Paginator }} = output.{{ .Paginator }}
+		input.{{ .InputPaginator }} = output.{{ .OutputPaginator }}
 	}
 	return nil
 }
@@ -117,6 +117,7 @@ var serviceNames = []string{
 	"sns",
 	"sqs",
 	"ssm",
+	"ssoadmin",
 	"storagegateway",
 	"swf",
 	"synthetics",
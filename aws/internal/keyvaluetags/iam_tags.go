@@ -11,6 +11,33 @@ import (
 
 // Custom IAM tag service update functions using the same format as generated code.
 
+// IamRoleListTags lists IAM role tags.
+// The identifier is the role name.
+func IamRoleListTags(conn *iam.IAM, identifier string) (KeyValueTags, error) {
+	input := &iam.ListRoleTagsInput{
+		RoleName: aws.String(identifier),
+	}
+	var tags []*iam.Tag
+
+	for {
+		output, err := conn.ListRoleTags(input)
+
+		if err != nil {
+			return New(nil), err
+		}
+
+		tags = append(tags, output.Tags...)
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.Marker = output.Marker
+	}
+
+	return IamKeyValueTags(tags), nil
+}
+
 // IamRoleUpdateTags updates IAM role tags.
 // The identifier is the role name.
 func IamRoleUpdateTags(conn *iam.IAM, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
@@ -46,6 +73,33 @@ func IamRoleUpdateTags(conn *iam.IAM, identifier string, oldTagsMap interface{},
 	return nil
 }
 
+// IamUserListTags lists IAM user tags.
+// The identifier is the user name.
+func IamUserListTags(conn *iam.IAM, identifier string) (KeyValueTags, error) {
+	input := &iam.ListUserTagsInput{
+		UserName: aws.String(identifier),
+	}
+	var tags []*iam.Tag
+
+	for {
+		output, err := conn.ListUserTags(input)
+
+		if err != nil {
+			return New(nil), err
+		}
+
+		tags = append(tags, output.Tags...)
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.Marker = output.Marker
+	}
+
+	return IamKeyValueTags(tags), nil
+}
+
 // IamUserUpdateTags updates IAM user tags.
 // The identifier is the user name.
 func IamUserUpdateTags(conn *iam.IAM, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
@@ -25,10 +25,20 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+
+	// ResourceTypes contains additional options applied only to the
+	// named Terraform resource types, e.g. "aws_instance".
+	ResourceTypes map[string]*IgnoreConfig
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -37,6 +47,17 @@ type IgnoreConfig struct {
 // across all these Go types, we convert them into this Go type.
 type KeyValueTags map[string]*TagData
 
+// MergeTags returns the result of keyvaluetags.Merge() on the given
+// DefaultConfig.Tags with KeyValueTags provided as an argument,
+// overriding the value of any tag with a matching key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
 // IgnoreAws returns non-AWS tag keys.
 func (tags KeyValueTags) IgnoreAws() KeyValueTags {
 	result := make(KeyValueTags)
@@ -50,13 +71,35 @@ func (tags KeyValueTags) IgnoreAws() KeyValueTags {
 	return result
 }
 
+// ForResourceType returns the configuration for a Terraform resource type,
+// combining the top-level options with any options for the resource type.
+func (config *IgnoreConfig) ForResourceType(resourceType string) *IgnoreConfig {
+	if config == nil {
+		return nil
+	}
+
+	resourceTypeConfig, ok := config.ResourceTypes[resourceType]
+
+	if !ok || resourceTypeConfig == nil {
+		return config
+	}
+
+	return &IgnoreConfig{
+		Keys:        config.Keys.Merge(resourceTypeConfig.Keys),
+		KeyPrefixes: config.KeyPrefixes.Merge(resourceTypeConfig.KeyPrefixes),
+		KeyRegexes:  append(append([]*regexp.Regexp{}, config.KeyRegexes...), resourceTypeConfig.KeyRegexes...),
+	}
+}
+
 // IgnoreConfig returns any tags not removed by a given configuration.
+// Options for specific resource types are not applied, see ForResourceType.
 func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	if config == nil {
 		return tags
 	}
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.IgnoreRegexes(config.KeyRegexes)
 	result = result.Ignore(config.Keys)
 
 	return result
@@ -109,6 +152,30 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagRegex := range ignoreTagRegexes {
+			if ignoreTagRegex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	result := make(KeyValueTags)
@@ -147,6 +214,24 @@ func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	return result
 }
 
+// RemoveDefaultConfig returns tags not present in a DefaultConfig object
+// in addition to tags with key/value pairs that override those in a DefaultConfig.
+func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -440,6 +525,8 @@ func (tags KeyValueTags) UrlEncode() string {
 // When passed []interface{}, all elements are treated as keys and assigned nil values.
 func New(i interface{}) KeyValueTags {
 	switch value := i.(type) {
+	case KeyValueTags:
+		return make(KeyValueTags).Merge(value)
 	case map[string]*TagData:
 		kvtm := make(KeyValueTags, len(value))
 
@@ -1,9 +1,95 @@
 package keyvaluetags
 
 import (
+	"regexp"
 	"testing"
 )
 
+func TestDefaultConfigMergeTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "empty config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "resource tags override",
+			tags: New(map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.MergeTags(testCase.tags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -246,6 +332,66 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key1":                       "value1",
+				"key2":                       "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^kubernetes\.io/cluster/`),
+					regexp.MustCompile(`1$`),
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys, key prefixes and key regexes",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+				KeyPrefixes: New([]string{
+					"key2",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^key[3]$`),
+				},
+			},
+			want: map[string]string{
+				"key4": "value4",
+			},
+		},
+		{
+			name: "resource types not applied",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ResourceTypes: map[string]*IgnoreConfig{
+					"aws_instance": {
+						Keys: New([]string{
+							"key1",
+						}),
+					},
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -402,6 +548,167 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreRegexes(t *testing.T) {
+	testCases := []struct {
+		name             string
+		tags             KeyValueTags
+		ignoreTagRegexes []*regexp.Regexp
+		want             map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "no regexes",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagRegexes: nil,
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key\d$`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "some matching",
+			tags: New(map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+				"key1":                       "value1",
+				"key2":                       "value2",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^kubernetes\.io/cluster/`),
+				regexp.MustCompile(`2`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "none matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^other`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreRegexes(testCase.ignoreTagRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestIgnoreConfigForResourceType(t *testing.T) {
+	ignoreConfig := &IgnoreConfig{
+		Keys: New([]string{
+			"key1",
+		}),
+		KeyRegexes: []*regexp.Regexp{
+			regexp.MustCompile(`^key2$`),
+		},
+		ResourceTypes: map[string]*IgnoreConfig{
+			"aws_instance": {
+				KeyPrefixes: New([]string{
+					"key3",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^key4$`),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		ignoreConfig *IgnoreConfig
+		resourceType string
+		want         map[string]string
+	}{
+		{
+			name:         "no config",
+			ignoreConfig: nil,
+			resourceType: "aws_instance",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+				"key5": "value5",
+			},
+		},
+		{
+			name:         "resource type configured",
+			ignoreConfig: ignoreConfig,
+			resourceType: "aws_instance",
+			want: map[string]string{
+				"key5": "value5",
+			},
+		},
+		{
+			name:         "resource type not configured",
+			ignoreConfig: ignoreConfig,
+			resourceType: "aws_vpc",
+			want: map[string]string{
+				"key3": "value3",
+				"key4": "value4",
+				"key5": "value5",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			tags := New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+				"key5": "value5",
+			})
+
+			got := tags.IgnoreConfig(testCase.ignoreConfig.ForResourceType(testCase.resourceType))
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+
+	if got := ignoreConfig.ForResourceType("aws_instance").KeyRegexes; len(got) != 2 {
+		t.Errorf("got %d key regexes, expected 2", len(got))
+	}
+
+	if got := ignoreConfig.KeyRegexes; len(got) != 1 {
+		t.Errorf("top-level key regexes modified, got %d, expected 1", len(got))
+	}
+}
+
 func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -1143,6 +1450,101 @@ func TestKeyValueTagsMerge(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "empty config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "keys all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "keys some matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "keys matching with different values",
+			tags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.RemoveDefaultConfig(testCase.defaultConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsOnly(t *testing.T) {
 	testCases := []struct {
 		name     string
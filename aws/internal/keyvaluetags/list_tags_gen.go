@@ -98,6 +98,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/transfer"
@@ -1719,6 +1720,24 @@ func SsmListTags(conn *ssm.SSM, identifier string, resourceType string) (KeyValu
 	return SsmKeyValueTags(output.TagList), nil
 }
 
+// SsoadminListTags lists ssoadmin service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SsoadminListTags(conn *ssoadmin.SSOAdmin, identifier string, resourceType string) (KeyValueTags, error) {
+	input := &ssoadmin.ListTagsForResourceInput{
+		ResourceArn: aws.String(identifier),
+		InstanceArn: aws.String(resourceType),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return SsoadminKeyValueTags(output.Tags), nil
+}
+
 // StoragegatewayListTags lists storagegateway service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
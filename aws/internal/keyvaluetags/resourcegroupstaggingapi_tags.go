@@ -0,0 +1,110 @@
+// +build !generate
+
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+// Custom Resource Groups Tagging API functions using the same format as generated code.
+
+// ResourcegroupstaggingapiListTags lists resource tags using the Resource Groups Tagging API.
+// The identifier is the resource Amazon Resource Name (ARN).
+// The API cannot look up a single resource, so the tagged resources of the ARN's service are
+// searched instead. Resources without tags are not returned by the API, so an empty set of
+// tags is returned for them.
+func ResourcegroupstaggingapiListTags(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, identifier string) (KeyValueTags, error) {
+	resourceARN, err := arn.Parse(identifier)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{resourceARN.Service}),
+	}
+
+	var tags []*resourcegroupstaggingapi.Tag
+
+	err = conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, mapping := range page.ResourceTagMappingList {
+			if mapping == nil || aws.StringValue(mapping.ResourceARN) != identifier {
+				continue
+			}
+
+			tags = mapping.Tags
+
+			return false
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return ResourcegroupstaggingapiKeyValueTags(tags), nil
+}
+
+// ResourcegroupstaggingapiUpdateTags updates resource tags using the Resource Groups Tagging API.
+// The identifier is the resource Amazon Resource Name (ARN).
+func ResourcegroupstaggingapiUpdateTags(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: aws.StringSlice([]string{identifier}),
+			TagKeys:         aws.StringSlice(removedTags.IgnoreAws().Keys()),
+		}
+
+		output, err := conn.UntagResources(input)
+
+		if err == nil && output != nil {
+			err = resourcegroupstaggingapiFailureError(output.FailedResourcesMap[identifier])
+		}
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: aws.StringSlice([]string{identifier}),
+			Tags:            aws.StringMap(updatedTags.IgnoreAws().Map()),
+		}
+
+		output, err := conn.TagResources(input)
+
+		if err == nil && output != nil {
+			err = resourcegroupstaggingapiFailureError(output.FailedResourcesMap[identifier])
+		}
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// resourcegroupstaggingapiFailureError returns an error for a resource the Resource Groups
+// Tagging API failed to tag or untag. The API reports such failures in its response
+// rather than as an API error.
+func resourcegroupstaggingapiFailureError(failure *resourcegroupstaggingapi.FailureInfo) error {
+	if failure == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.StringValue(failure.ErrorCode), aws.StringValue(failure.ErrorMessage))
+}
@@ -108,6 +108,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
@@ -331,6 +332,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(sqs.New)
 	case "ssm":
 		funcType = reflect.TypeOf(ssm.New)
+	case "ssoadmin":
+		funcType = reflect.TypeOf(ssoadmin.New)
 	case "storagegateway":
 		funcType = reflect.TypeOf(storagegateway.New)
 	case "swf":
@@ -829,6 +832,8 @@ func ServiceTagResourceTypeField(serviceName string) string {
 		return "ResourceType"
 	case "ssm":
 		return "ResourceType"
+	case "ssoadmin":
+		return "InstanceArn"
 	default:
 		return ""
 	}
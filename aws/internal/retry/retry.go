@@ -0,0 +1,199 @@
+package retry
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	// ModeStandard retries with exponential backoff and only applies a client-side
+	// rate limit when one is configured.
+	ModeStandard = "standard"
+
+	// ModeAdaptive retries with exponential backoff and additionally adjusts a
+	// client-side rate limit based on throttling responses.
+	ModeAdaptive = "adaptive"
+)
+
+// Modes returns all retry mode values.
+func Modes() []string {
+	return []string{
+		ModeStandard,
+		ModeAdaptive,
+	}
+}
+
+const (
+	handlerNameRetryer = "terraform-provider-aws.retry.Retryer"
+	handlerNameLimiter = "terraform-provider-aws.retry.RateLimiter"
+	handlerNameAdapt   = "terraform-provider-aws.retry.Adapt"
+)
+
+// Policy contains settings for retrying and rate limiting API requests.
+// Zero values are unset and, for service overrides, inherit the provider-wide value.
+type Policy struct {
+	// Mode is the retry mode, either ModeStandard or ModeAdaptive.
+	Mode string
+
+	// MaxRetries is the maximum number of times a request is retried.
+	MaxRetries int
+
+	// MaxBackoff is the maximum delay between retries of a request.
+	MaxBackoff time.Duration
+
+	// RateLimit is the maximum number of requests per second. Zero is unlimited.
+	RateLimit float64
+
+	// Burst is the maximum number of requests sent at once before the rate limit applies.
+	Burst int
+}
+
+// Config contains the provider-wide retry policy and any per-service overrides.
+type Config struct {
+	Policy
+
+	// ServicePolicies contains per-service overrides, keyed by the AWS SDK
+	// service name or package name (for example "ec2" or "servicequotas").
+	ServicePolicies map[string]*Policy
+}
+
+// ConfigureSession installs request handlers implementing the retry policy on the
+// session. Service clients subsequently created from copies of the session share
+// the policy and any rate limiters.
+func (c *Config) ConfigureSession(sess *session.Session) {
+	if c == nil || sess == nil {
+		return
+	}
+
+	defaultHandler := newPolicyHandler(c.Policy, nil)
+	serviceHandlers := make(map[string]*policyHandler, len(c.ServicePolicies))
+
+	for service, policy := range c.ServicePolicies {
+		if policy == nil {
+			continue
+		}
+
+		serviceHandlers[service] = newPolicyHandler(c.Policy.merge(*policy), defaultHandler)
+	}
+
+	handlerFor := func(r *request.Request) *policyHandler {
+		for _, name := range serviceNames(r.ClientInfo) {
+			if h, ok := serviceHandlers[name]; ok {
+				return h
+			}
+		}
+
+		return defaultHandler
+	}
+
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: handlerNameRetryer,
+		Fn: func(r *request.Request) {
+			r.Retryer = handlerFor(r).retryer
+		},
+	})
+
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: handlerNameLimiter,
+		Fn: func(r *request.Request) {
+			h := handlerFor(r)
+
+			if h.limiter == nil {
+				return
+			}
+
+			if err := h.limiter.Wait(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled waiting for rate limiter", err)
+			}
+		},
+	})
+
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: handlerNameAdapt,
+		Fn: func(r *request.Request) {
+			h := handlerFor(r)
+
+			if h.limiter == nil {
+				return
+			}
+
+			switch {
+			case r.IsErrorThrottle():
+				h.limiter.Throttled()
+			case r.Error == nil:
+				h.limiter.Succeeded()
+			}
+		},
+	})
+}
+
+// serviceNames returns the names a service can be configured with: the AWS SDK
+// service name (for example "events") and the AWS SDK package name (for example "cloudwatchevents").
+func serviceNames(info metadata.ClientInfo) []string {
+	return []string{
+		info.ServiceName,
+		strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(info.ServiceID)),
+	}
+}
+
+// merge returns a copy of the policy with any values set in the override applied.
+func (p Policy) merge(override Policy) Policy {
+	if override.Mode != "" {
+		p.Mode = override.Mode
+	}
+
+	if override.MaxRetries != 0 {
+		p.MaxRetries = override.MaxRetries
+	}
+
+	if override.MaxBackoff != 0 {
+		p.MaxBackoff = override.MaxBackoff
+	}
+
+	if override.RateLimit != 0 {
+		p.RateLimit = override.RateLimit
+	}
+
+	if override.Burst != 0 {
+		p.Burst = override.Burst
+	}
+
+	return p
+}
+
+// policyHandler holds the request retryer and rate limiter for a policy.
+type policyHandler struct {
+	policy  Policy
+	retryer request.Retryer
+	limiter *TokenBucket
+}
+
+// newPolicyHandler returns a handler for the policy. The rate limiter of the parent
+// handler is shared unless the policy requires a different one.
+func newPolicyHandler(p Policy, parent *policyHandler) *policyHandler {
+	h := &policyHandler{
+		policy: p,
+		retryer: client.DefaultRetryer{
+			NumMaxRetries:    p.MaxRetries,
+			MaxRetryDelay:    p.MaxBackoff,
+			MaxThrottleDelay: p.MaxBackoff,
+		},
+	}
+
+	adaptive := p.Mode == ModeAdaptive
+
+	switch {
+	case parent != nil && parent.limiter != nil && parent.policy.Mode == p.Mode && parent.policy.RateLimit == p.RateLimit && parent.policy.Burst == p.Burst:
+		h.limiter = parent.limiter
+	case adaptive || p.RateLimit > 0:
+		h.limiter = NewTokenBucket(p.RateLimit, p.Burst, adaptive)
+	}
+
+	return h
+}
@@ -0,0 +1,178 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestPolicyMerge(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Policy   Policy
+		Override Policy
+		Expected Policy
+	}{
+		{
+			Name: "empty override",
+			Policy: Policy{
+				Mode:       ModeStandard,
+				MaxRetries: 25,
+			},
+			Expected: Policy{
+				Mode:       ModeStandard,
+				MaxRetries: 25,
+			},
+		},
+		{
+			Name: "partial override",
+			Policy: Policy{
+				Mode:       ModeStandard,
+				MaxRetries: 25,
+				MaxBackoff: 5 * time.Minute,
+			},
+			Override: Policy{
+				Mode:      ModeAdaptive,
+				RateLimit: 10,
+			},
+			Expected: Policy{
+				Mode:       ModeAdaptive,
+				MaxRetries: 25,
+				MaxBackoff: 5 * time.Minute,
+				RateLimit:  10,
+			},
+		},
+		{
+			Name: "full override",
+			Policy: Policy{
+				Mode:       ModeStandard,
+				MaxRetries: 25,
+				MaxBackoff: 5 * time.Minute,
+				RateLimit:  10,
+				Burst:      5,
+			},
+			Override: Policy{
+				Mode:       ModeAdaptive,
+				MaxRetries: 5,
+				MaxBackoff: time.Minute,
+				RateLimit:  2,
+				Burst:      1,
+			},
+			Expected: Policy{
+				Mode:       ModeAdaptive,
+				MaxRetries: 5,
+				MaxBackoff: time.Minute,
+				RateLimit:  2,
+				Burst:      1,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Policy.merge(testCase.Override)
+
+			if got != testCase.Expected {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigConfigureSession(t *testing.T) {
+	config := &Config{
+		Policy: Policy{
+			Mode:       ModeStandard,
+			MaxRetries: 25,
+		},
+		ServicePolicies: map[string]*Policy{
+			"organizations": {
+				MaxRetries: 5,
+			},
+			"servicequotas": {
+				MaxRetries: 3,
+			},
+		},
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String("http://localhost"),
+		MaxRetries:  aws.Int(1),
+		Region:      aws.String("us-west-2"), // lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	config.ConfigureSession(sess)
+
+	testCases := []struct {
+		Name               string
+		ServiceName        string
+		ServiceID          string
+		ExpectedMaxRetries int
+	}{
+		{
+			Name:               "default policy",
+			ServiceName:        "ec2",
+			ServiceID:          "EC2",
+			ExpectedMaxRetries: 25,
+		},
+		{
+			Name:               "service policy",
+			ServiceName:        "organizations",
+			ServiceID:          "Organizations",
+			ExpectedMaxRetries: 5,
+		},
+		{
+			Name:               "service policy package name",
+			ServiceName:        "Service Quotas",
+			ServiceID:          "Service Quotas",
+			ExpectedMaxRetries: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			c := client.New(*sess.Config, metadata.ClientInfo{ServiceName: testCase.ServiceName, ServiceID: testCase.ServiceID, Endpoint: "http://localhost"}, sess.Handlers)
+			r := c.NewRequest(&request.Operation{Name: "Test", HTTPMethod: "POST", HTTPPath: "/"}, nil, nil)
+
+			if err := r.Build(); err != nil {
+				t.Fatalf("error building request: %s", err)
+			}
+
+			if got := r.MaxRetries(); got != testCase.ExpectedMaxRetries {
+				t.Errorf("got %d max retries, expected %d", got, testCase.ExpectedMaxRetries)
+			}
+		})
+	}
+}
+
+func TestConfigConfigureSessionNil(t *testing.T) {
+	var config *Config
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String("us-west-2"), // lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	validateLen := sess.Handlers.Validate.Len()
+
+	config.ConfigureSession(sess)
+
+	if got := sess.Handlers.Validate.Len(); got != validateLen {
+		t.Errorf("got %d validate handlers, expected %d", got, validateLen)
+	}
+}
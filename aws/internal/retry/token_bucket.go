@@ -0,0 +1,198 @@
+package retry
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// adaptiveMinRate is the lowest rate, in requests per second, that an adaptive
+	// token bucket will reduce to when throttled.
+	adaptiveMinRate = 0.5
+
+	// adaptiveBeta is the multiplicative decrease applied to the rate on throttling.
+	adaptiveBeta = 0.7
+
+	// adaptiveIncrease is the fraction of the maximum rate recovered on each successful request.
+	adaptiveIncrease = 0.05
+
+	// measurementWindow is the window used to measure the client request rate.
+	measurementWindow = time.Second
+)
+
+// TokenBucket is a client-side rate limiter. Each request consumes one token and tokens
+// are refilled at a fixed rate up to the configured burst capacity.
+//
+// A token bucket created with a zero rate does not limit requests until it is
+// switched into adaptive operation by a throttling response (see Throttled).
+type TokenBucket struct {
+	mu sync.Mutex
+
+	rate    float64
+	maxRate float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+
+	adaptive bool
+	enabled  bool
+
+	windowStart  time.Time
+	windowCount  int
+	measuredRate float64
+
+	now func() time.Time
+}
+
+// NewTokenBucket returns a token bucket refilling at rate tokens per second with the
+// given burst capacity. A zero rate disables limiting.
+// If adaptive is true, the rate is reduced on throttling responses and recovers on
+// successful responses, never exceeding the initial rate if one was configured.
+func NewTokenBucket(rate float64, burst int, adaptive bool) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	b := &TokenBucket{
+		adaptive: adaptive,
+		burst:    float64(burst),
+		enabled:  rate > 0,
+		maxRate:  rate,
+		now:      time.Now,
+		rate:     rate,
+		tokens:   float64(burst),
+	}
+
+	b.last = b.now()
+	b.windowStart = b.last
+
+	return b
+}
+
+// Rate returns the current refill rate in tokens per second.
+// A zero value indicates that requests are not limited.
+func (b *TokenBucket) Rate() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.enabled {
+		return 0
+	}
+
+	return b.rate
+}
+
+// Wait blocks until a token is available or the context is done.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	delay := b.reserve()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Throttled records a throttling response. Adaptive token buckets reduce their rate.
+func (b *TokenBucket) Throttled() {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.enabled {
+		// Start limiting at the rate which caused the throttling.
+		b.enabled = true
+		b.rate = math.Max(b.sendRate(), adaptiveMinRate)
+		b.maxRate = b.rate
+		b.tokens = 0
+		b.last = b.now()
+	}
+
+	b.refill()
+	b.rate = math.Max(b.rate*adaptiveBeta, adaptiveMinRate)
+}
+
+// Succeeded records a successful response. Adaptive token buckets increase their rate.
+func (b *TokenBucket) Succeeded() {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.enabled {
+		return
+	}
+
+	b.refill()
+	b.rate = math.Min(b.rate+b.maxRate*adaptiveIncrease, b.maxRate)
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.measure()
+
+	if !b.enabled {
+		return 0
+	}
+
+	b.refill()
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// refill adds the tokens accrued since the last refill.
+func (b *TokenBucket) refill() {
+	now := b.now()
+	elapsed := now.Sub(b.last).Seconds()
+	b.last = now
+
+	if elapsed <= 0 {
+		return
+	}
+
+	b.tokens = math.Min(b.tokens+elapsed*b.rate, b.burst)
+}
+
+// sendRate returns the measured client request rate.
+func (b *TokenBucket) sendRate() float64 {
+	if elapsed := b.now().Sub(b.windowStart).Seconds(); b.windowCount > 0 && elapsed > 0 {
+		return math.Max(b.measuredRate, float64(b.windowCount)/elapsed)
+	}
+
+	return b.measuredRate
+}
+
+// measure records a request in the client request rate measurement.
+func (b *TokenBucket) measure() {
+	now := b.now()
+	b.windowCount++
+
+	if elapsed := now.Sub(b.windowStart); elapsed >= measurementWindow {
+		b.measuredRate = float64(b.windowCount) / elapsed.Seconds()
+		b.windowCount = 0
+		b.windowStart = now
+	}
+}
@@ -0,0 +1,139 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestTokenBucket(rate float64, burst int, adaptive bool) (*TokenBucket, *testClock) {
+	clock := &testClock{now: time.Unix(0, 0)}
+
+	b := NewTokenBucket(rate, burst, adaptive)
+	b.now = clock.Now
+	b.last = clock.Now()
+	b.windowStart = b.last
+
+	return b, clock
+}
+
+func TestTokenBucketUnlimited(t *testing.T) {
+	b, _ := newTestTokenBucket(0, 1, false)
+
+	for i := 0; i < 100; i++ {
+		if delay := b.reserve(); delay != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, delay)
+		}
+	}
+
+	if got := b.Rate(); got != 0 {
+		t.Errorf("got rate %f, expected 0", got)
+	}
+}
+
+func TestTokenBucketLimited(t *testing.T) {
+	b, clock := newTestTokenBucket(2, 2, false)
+
+	for i := 0; i < 2; i++ {
+		if delay := b.reserve(); delay != 0 {
+			t.Fatalf("burst request %d: got delay %s, expected none", i, delay)
+		}
+	}
+
+	if delay, expected := b.reserve(), 500*time.Millisecond; delay != expected {
+		t.Errorf("got delay %s, expected %s", delay, expected)
+	}
+
+	clock.Advance(2 * time.Second)
+
+	if delay := b.reserve(); delay != 0 {
+		t.Errorf("got delay %s after refill, expected none", delay)
+	}
+}
+
+func TestTokenBucketStandardIgnoresThrottling(t *testing.T) {
+	b, _ := newTestTokenBucket(10, 1, false)
+
+	b.Throttled()
+
+	if got, expected := b.Rate(), 10.0; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+}
+
+func TestTokenBucketAdaptive(t *testing.T) {
+	b, clock := newTestTokenBucket(10, 1, true)
+
+	b.Throttled()
+
+	if got, expected := b.Rate(), 7.0; got != expected {
+		t.Errorf("got rate %f after throttling, expected %f", got, expected)
+	}
+
+	for i := 0; i < 100; i++ {
+		clock.Advance(time.Second)
+		b.Succeeded()
+	}
+
+	if got, expected := b.Rate(), 10.0; got != expected {
+		t.Errorf("got rate %f after recovery, expected %f", got, expected)
+	}
+}
+
+func TestTokenBucketAdaptiveMinimumRate(t *testing.T) {
+	b, _ := newTestTokenBucket(1, 1, true)
+
+	for i := 0; i < 100; i++ {
+		b.Throttled()
+	}
+
+	if got := b.Rate(); got != adaptiveMinRate {
+		t.Errorf("got rate %f, expected %f", got, adaptiveMinRate)
+	}
+}
+
+func TestTokenBucketAdaptiveEnabledByThrottling(t *testing.T) {
+	b, clock := newTestTokenBucket(0, 1, true)
+
+	for i := 0; i < 20; i++ {
+		clock.Advance(100 * time.Millisecond)
+		b.reserve()
+	}
+
+	if got := b.Rate(); got != 0 {
+		t.Fatalf("got rate %f before throttling, expected 0", got)
+	}
+
+	b.Throttled()
+
+	if got := b.Rate(); got <= 0 {
+		t.Errorf("got rate %f after throttling, expected limiting", got)
+	}
+}
+
+func TestTokenBucketWaitContextCanceled(t *testing.T) {
+	b := NewTokenBucket(0.001, 1, false)
+
+	if err := b.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := b.Wait(ctx); err == nil {
+		t.Error("expected error, got none")
+	}
+}
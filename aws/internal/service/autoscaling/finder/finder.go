@@ -0,0 +1,26 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+)
+
+// InstanceRefresh returns the instance refresh corresponding to the specified Auto Scaling Group name and instance refresh ID.
+// Returns nil and potentially an API error if no instance refresh is found.
+func InstanceRefresh(conn *autoscaling.AutoScaling, asgName, instanceRefreshID string) (*autoscaling.InstanceRefresh, error) {
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(asgName),
+		InstanceRefreshIds:   aws.StringSlice([]string{instanceRefreshID}),
+	}
+
+	output, err := conn.DescribeInstanceRefreshes(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.InstanceRefreshes) == 0 || output.InstanceRefreshes[0] == nil {
+		return nil, nil
+	}
+
+	return output.InstanceRefreshes[0], nil
+}
@@ -0,0 +1,36 @@
+package waiter
+
+import (
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/autoscaling/finder"
+)
+
+const (
+	instanceRefreshStatusNotFound = "NotFound"
+	instanceRefreshStatusUnknown  = "Unknown"
+)
+
+// InstanceRefreshStatus fetches the InstanceRefresh and its Status
+func InstanceRefreshStatus(conn *autoscaling.AutoScaling, asgName, instanceRefreshID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instanceRefresh, err := finder.InstanceRefresh(conn, asgName, instanceRefreshID)
+
+		if err != nil {
+			return nil, instanceRefreshStatusUnknown, err
+		}
+
+		if instanceRefresh == nil {
+			return nil, instanceRefreshStatusNotFound, nil
+		}
+
+		if statusReason := aws.StringValue(instanceRefresh.StatusReason); statusReason != "" {
+			log.Printf("[INFO] Auto Scaling Group (%s) Instance Refresh (%s) status reason: %s", asgName, instanceRefreshID, statusReason)
+		}
+
+		return instanceRefresh, aws.StringValue(instanceRefresh.Status), nil
+	}
+}
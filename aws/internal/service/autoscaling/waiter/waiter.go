@@ -0,0 +1,63 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an InstanceRefresh to return Cancelled
+	InstanceRefreshCancelledTimeout = 15 * time.Minute
+)
+
+// InstanceRefreshCancelled waits for an InstanceRefresh to return Cancelled
+func InstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, instanceRefreshID string) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusCancelling,
+		},
+		Target: []string{
+			autoscaling.InstanceRefreshStatusCancelled,
+			// Failed and Successful are also acceptable end-states when cancelling
+			autoscaling.InstanceRefreshStatusFailed,
+			autoscaling.InstanceRefreshStatusSuccessful,
+		},
+		Refresh: InstanceRefreshStatus(conn, asgName, instanceRefreshID),
+		Timeout: InstanceRefreshCancelledTimeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// InstanceRefreshSuccessful waits for an InstanceRefresh to return Successful
+func InstanceRefreshSuccessful(conn *autoscaling.AutoScaling, asgName, instanceRefreshID string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusInProgress,
+		},
+		Target:  []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh: InstanceRefreshStatus(conn, asgName, instanceRefreshID),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,215 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// CachePolicyByID returns the CloudFront cache policy, along with its ETag, corresponding to the specified identifier.
+func CachePolicyByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetCachePolicyOutput, error) {
+	input := &cloudfront.GetCachePolicyInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetCachePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchCachePolicy) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CachePolicy == nil || output.CachePolicy.CachePolicyConfig == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output, nil
+}
+
+// CachePolicyByName returns the CloudFront cache policy corresponding to the specified name.
+// Both custom and managed cache policies are searched.
+func CachePolicyByName(conn *cloudfront.CloudFront, name string) (*cloudfront.CachePolicy, error) {
+	input := &cloudfront.ListCachePoliciesInput{}
+
+	for {
+		output, err := conn.ListCachePolicies(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.CachePolicyList == nil {
+			break
+		}
+
+		for _, summary := range output.CachePolicyList.Items {
+			if summary == nil || summary.CachePolicy == nil || summary.CachePolicy.CachePolicyConfig == nil {
+				continue
+			}
+
+			if aws.StringValue(summary.CachePolicy.CachePolicyConfig.Name) == name {
+				return summary.CachePolicy, nil
+			}
+		}
+
+		if aws.StringValue(output.CachePolicyList.NextMarker) == "" {
+			break
+		}
+
+		input.Marker = output.CachePolicyList.NextMarker
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+		Message:     "no matching cache policy found",
+	}
+}
+
+// KeyGroupByID returns the CloudFront key group, along with its ETag, corresponding to the specified identifier.
+func KeyGroupByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetKeyGroupOutput, error) {
+	input := &cloudfront.GetKeyGroupInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetKeyGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchResource) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.KeyGroup == nil || output.KeyGroup.KeyGroupConfig == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output, nil
+}
+
+// OriginRequestPolicyByID returns the CloudFront origin request policy, along with its ETag, corresponding to the specified identifier.
+func OriginRequestPolicyByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetOriginRequestPolicyOutput, error) {
+	input := &cloudfront.GetOriginRequestPolicyInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetOriginRequestPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginRequestPolicy) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OriginRequestPolicy == nil || output.OriginRequestPolicy.OriginRequestPolicyConfig == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output, nil
+}
+
+// OriginRequestPolicyByName returns the CloudFront origin request policy corresponding to the specified name.
+// Both custom and managed origin request policies are searched.
+func OriginRequestPolicyByName(conn *cloudfront.CloudFront, name string) (*cloudfront.OriginRequestPolicy, error) {
+	input := &cloudfront.ListOriginRequestPoliciesInput{}
+
+	for {
+		output, err := conn.ListOriginRequestPolicies(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.OriginRequestPolicyList == nil {
+			break
+		}
+
+		for _, summary := range output.OriginRequestPolicyList.Items {
+			if summary == nil || summary.OriginRequestPolicy == nil || summary.OriginRequestPolicy.OriginRequestPolicyConfig == nil {
+				continue
+			}
+
+			if aws.StringValue(summary.OriginRequestPolicy.OriginRequestPolicyConfig.Name) == name {
+				return summary.OriginRequestPolicy, nil
+			}
+		}
+
+		if aws.StringValue(output.OriginRequestPolicyList.NextMarker) == "" {
+			break
+		}
+
+		input.Marker = output.OriginRequestPolicyList.NextMarker
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+		Message:     "no matching origin request policy found",
+	}
+}
+
+// RealtimeLogConfigByARN returns the CloudFront real-time log configuration corresponding to the specified ARN.
+func RealtimeLogConfigByARN(conn *cloudfront.CloudFront, arn string) (*cloudfront.RealtimeLogConfig, error) {
+	return realtimeLogConfig(conn, &cloudfront.GetRealtimeLogConfigInput{
+		ARN: aws.String(arn),
+	})
+}
+
+// RealtimeLogConfigByName returns the CloudFront real-time log configuration corresponding to the specified name.
+func RealtimeLogConfigByName(conn *cloudfront.CloudFront, name string) (*cloudfront.RealtimeLogConfig, error) {
+	return realtimeLogConfig(conn, &cloudfront.GetRealtimeLogConfigInput{
+		Name: aws.String(name),
+	})
+}
+
+func realtimeLogConfig(conn *cloudfront.CloudFront, input *cloudfront.GetRealtimeLogConfigInput) (*cloudfront.RealtimeLogConfig, error) {
+	output, err := conn.GetRealtimeLogConfig(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchRealtimeLogConfig) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RealtimeLogConfig == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output.RealtimeLogConfig, nil
+}
@@ -9,6 +9,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/lister"
 )
 
+func Archive(conn *events.CloudWatchEvents, name string) (*events.DescribeArchiveOutput, error) {
+	input := &events.DescribeArchiveInput{
+		ArchiveName: aws.String(name),
+	}
+
+	return conn.DescribeArchive(input)
+}
+
 func Rule(conn *events.CloudWatchEvents, eventBusName, ruleName string) (*events.DescribeRuleOutput, error) {
 	input := events.DescribeRuleInput{
 		Name: aws.String(ruleName),
@@ -1,4 +1,4 @@
-//go:generate go run ../../../generators/listpages/main.go -function=ListEventBuses,ListRules,ListTargetsByRule github.com/aws/aws-sdk-go/service/cloudwatchevents
+//go:generate go run ../../../generators/listpages/main.go -function=ListArchives,ListEventBuses,ListRules,ListTargetsByRule github.com/aws/aws-sdk-go/service/cloudwatchevents
 
 package lister
 
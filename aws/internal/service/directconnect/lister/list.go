@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/listpages/main.go -function=DescribeDirectConnectGateways github.com/aws/aws-sdk-go/service/directconnect
+
+package lister
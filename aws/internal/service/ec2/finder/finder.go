@@ -3,6 +3,8 @@ package finder
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 )
 
@@ -74,21 +76,35 @@ func ClientVpnRouteByID(conn *ec2.EC2, routeID string) (*ec2.DescribeClientVpnRo
 	return ClientVpnRoute(conn, endpointID, targetSubnetID, destinationCidr)
 }
 
-// SecurityGroupByID looks up a security group by ID. When not found, returns nil and potentially an API error.
+// SecurityGroupByID looks up a security group by ID.
+// Returns a resource.NotFoundError if no security group is found.
 func SecurityGroupByID(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
-	req := &ec2.DescribeSecurityGroupsInput{
+	input := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: aws.StringSlice([]string{id}),
 	}
-	result, err := conn.DescribeSecurityGroups(req)
+
+	output, err := conn.DescribeSecurityGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.InvalidSecurityGroupIDNotFound) || tfawserr.ErrCodeEquals(err, tfec2.InvalidGroupNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	if result == nil || len(result.SecurityGroups) == 0 || result.SecurityGroups[0] == nil {
-		return nil, nil
+	if output == nil || len(output.SecurityGroups) == 0 || output.SecurityGroups[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
 	}
 
-	return result.SecurityGroups[0], nil
+	return output.SecurityGroups[0], nil
 }
 
 // VpcPeeringConnectionByID returns the VPC peering connection corresponding to the specified identifier.
@@ -166,3 +182,242 @@ func ManagedPrefixListByID(conn *ec2.EC2, id string) (*ec2.ManagedPrefixList, er
 
 	return output.PrefixLists[0], nil
 }
+
+// InstanceByID looks up an EC2 instance by ID.
+// Terminated instances are treated as not found.
+// Returns a resource.NotFoundError if no instance is found.
+func InstanceByID(conn *ec2.EC2, id string) (*ec2.Instance, error) {
+	input := &ec2.DescribeInstancesInput{
+		InstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeInstances(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidInstanceIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Reservations) == 0 || output.Reservations[0] == nil || len(output.Reservations[0].Instances) == 0 || output.Reservations[0].Instances[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	instance := output.Reservations[0].Instances[0]
+
+	if instance.State != nil && aws.StringValue(instance.State.Name) == ec2.InstanceStateNameTerminated {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "EC2 Instance terminated",
+		}
+	}
+
+	return instance, nil
+}
+
+// InternetGatewayByID looks up an internet gateway by ID.
+// Returns a resource.NotFoundError if no internet gateway is found.
+func InternetGatewayByID(conn *ec2.EC2, id string) (*ec2.InternetGateway, error) {
+	input := &ec2.DescribeInternetGatewaysInput{
+		InternetGatewayIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeInternetGateways(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidInternetGatewayIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.InternetGateways) == 0 || output.InternetGateways[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.InternetGateways[0], nil
+}
+
+// NatGatewayByID looks up a NAT gateway by ID.
+// Deleted NAT gateways are treated as not found.
+// Returns a resource.NotFoundError if no NAT gateway is found.
+func NatGatewayByID(conn *ec2.EC2, id string) (*ec2.NatGateway, error) {
+	input := &ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeNatGateways(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeNatGatewayNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.NatGateways) == 0 || output.NatGateways[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	natGateway := output.NatGateways[0]
+
+	if aws.StringValue(natGateway.State) == ec2.NatGatewayStateDeleted {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "NAT Gateway deleted",
+		}
+	}
+
+	return natGateway, nil
+}
+
+// NetworkInterfaceByID looks up a network interface by ID.
+// Returns a resource.NotFoundError if no network interface is found.
+func NetworkInterfaceByID(conn *ec2.EC2, id string) (*ec2.NetworkInterface, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeNetworkInterfaces(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidNetworkInterfaceIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.NetworkInterfaces) == 0 || output.NetworkInterfaces[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.NetworkInterfaces[0], nil
+}
+
+// RouteTableByID looks up a route table by ID.
+// Returns a resource.NotFoundError if no route table is found.
+func RouteTableByID(conn *ec2.EC2, id string) (*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		RouteTableIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeRouteTables(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidRouteTableIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RouteTables) == 0 || output.RouteTables[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.RouteTables[0], nil
+}
+
+// SubnetByID looks up a subnet by ID.
+// Returns a resource.NotFoundError if no subnet is found.
+func SubnetByID(conn *ec2.EC2, id string) (*ec2.Subnet, error) {
+	input := &ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeSubnets(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidSubnetIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Subnets) == 0 || output.Subnets[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.Subnets[0], nil
+}
+
+// VpcByID looks up a VPC by ID.
+// Returns a resource.NotFoundError if no VPC is found.
+func VpcByID(conn *ec2.EC2, id string) (*ec2.Vpc, error) {
+	input := &ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeVpcs(input)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidVpcIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Vpcs) == 0 || output.Vpcs[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.Vpcs[0], nil
+}
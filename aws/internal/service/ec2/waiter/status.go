@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 const (
@@ -221,18 +222,13 @@ const (
 func SecurityGroupStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		group, err := finder.SecurityGroupByID(conn, id)
-		if tfawserr.ErrCodeEquals(err, tfec2.InvalidSecurityGroupIDNotFound) ||
-			tfawserr.ErrCodeEquals(err, tfec2.InvalidGroupNotFound) {
+		if tfresource.NotFound(err) {
 			return nil, SecurityGroupStatusNotFound, nil
 		}
 		if err != nil {
 			return nil, SecurityGroupStatusUnknown, err
 		}
 
-		if group == nil {
-			return nil, SecurityGroupStatusNotFound, nil
-		}
-
 		return group, SecurityGroupStatusCreated, nil
 	}
 }
@@ -316,3 +312,143 @@ func ManagedPrefixListState(conn *ec2.EC2, prefixListId string) resource.StateRe
 		return managedPrefixList, aws.StringValue(managedPrefixList.State), nil
 	}
 }
+
+// InstanceState fetches the EC2 instance and its state.
+// Terminated instances are not found.
+func InstanceState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := finder.InstanceByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if instance.State == nil {
+			return nil, "", nil
+		}
+
+		return instance, aws.StringValue(instance.State.Name), nil
+	}
+}
+
+const (
+	InternetGatewayStatusAvailable = "available"
+)
+
+// InternetGatewayStatus fetches the internet gateway and a synthetic status, as
+// internet gateways do not have a state of their own.
+func InternetGatewayStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		internetGateway, err := finder.InternetGatewayByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return internetGateway, InternetGatewayStatusAvailable, nil
+	}
+}
+
+// NatGatewayState fetches the NAT gateway and its state.
+// Deleted NAT gateways are not found.
+func NatGatewayState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		natGateway, err := finder.NatGatewayByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return natGateway, aws.StringValue(natGateway.State), nil
+	}
+}
+
+const (
+	// There is no ec2.NetworkInterfaceStatusPending constant.
+	NetworkInterfaceStatusPending = "pending"
+)
+
+// NetworkInterfaceStatus fetches the network interface and its status.
+func NetworkInterfaceStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		networkInterface, err := finder.NetworkInterfaceByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return networkInterface, aws.StringValue(networkInterface.Status), nil
+	}
+}
+
+const (
+	RouteTableStatusReady = "ready"
+)
+
+// RouteTableStatus fetches the route table and a synthetic status, as
+// route tables do not have a state of their own.
+func RouteTableStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		routeTable, err := finder.RouteTableByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return routeTable, RouteTableStatusReady, nil
+	}
+}
+
+// SubnetState fetches the subnet and its state.
+func SubnetState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		subnet, err := finder.SubnetByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return subnet, aws.StringValue(subnet.State), nil
+	}
+}
+
+// VpcState fetches the VPC and its state.
+func VpcState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vpc, err := finder.VpcByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return vpc, aws.StringValue(vpc.State), nil
+	}
+}
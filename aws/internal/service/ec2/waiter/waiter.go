@@ -1,8 +1,10 @@
 package waiter
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -11,6 +13,9 @@ import (
 const (
 	// Maximum amount of time to wait for EC2 Instance attribute modifications to propagate
 	InstanceAttributePropagationTimeout = 2 * time.Minute
+
+	// Maximum amount of time to wait for a newly created EC2 resource to become visible
+	PropagationTimeout = 2 * time.Minute
 )
 
 const (
@@ -344,3 +349,265 @@ func ManagedPrefixListDeleted(conn *ec2.EC2, prefixListId string) error {
 
 	return nil
 }
+
+const (
+	InstanceStatePollDelay = 10 * time.Second
+
+	InstanceStateMinTimeout = 3 * time.Second
+)
+
+// InstanceRunning waits for an EC2 instance to become running.
+func InstanceRunning(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameStopped},
+		Target:     []string{ec2.InstanceStateNameRunning},
+		Refresh:    InstanceState(conn, id),
+		Timeout:    timeout,
+		Delay:      InstanceStatePollDelay,
+		MinTimeout: InstanceStateMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Instance); ok {
+		return output, instanceStateReasonError(output, err)
+	}
+
+	return nil, err
+}
+
+// InstanceStopped waits for an EC2 instance to become stopped.
+func InstanceStopped(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.InstanceStateNamePending,
+			ec2.InstanceStateNameRunning,
+			ec2.InstanceStateNameShuttingDown,
+			ec2.InstanceStateNameStopping,
+		},
+		Target:     []string{ec2.InstanceStateNameStopped},
+		Refresh:    InstanceState(conn, id),
+		Timeout:    timeout,
+		Delay:      InstanceStatePollDelay,
+		MinTimeout: InstanceStateMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Instance); ok {
+		return output, instanceStateReasonError(output, err)
+	}
+
+	return nil, err
+}
+
+// InstanceTerminated waits for an EC2 instance to become terminated.
+func InstanceTerminated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Instance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.InstanceStateNamePending,
+			ec2.InstanceStateNameRunning,
+			ec2.InstanceStateNameShuttingDown,
+			ec2.InstanceStateNameStopped,
+			ec2.InstanceStateNameStopping,
+		},
+		Target:     []string{},
+		Refresh:    InstanceState(conn, id),
+		Timeout:    timeout,
+		Delay:      InstanceStatePollDelay,
+		MinTimeout: InstanceStateMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Instance); ok {
+		return output, instanceStateReasonError(output, err)
+	}
+
+	return nil, err
+}
+
+// instanceStateReasonError adds the reason for the EC2 instance's last state transition to a waiter error.
+func instanceStateReasonError(instance *ec2.Instance, err error) error {
+	if err == nil || instance == nil || instance.StateReason == nil {
+		return err
+	}
+
+	return fmt.Errorf("%w (reason: %s: %s)", err, aws.StringValue(instance.StateReason.Code), aws.StringValue(instance.StateReason.Message))
+}
+
+const (
+	InternetGatewayAvailableTimeout = 10 * time.Minute
+)
+
+// InternetGatewayAvailable waits for a newly created internet gateway to become visible.
+func InternetGatewayAvailable(conn *ec2.EC2, id string) (*ec2.InternetGateway, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{InternetGatewayStatusAvailable},
+		Refresh: InternetGatewayStatus(conn, id),
+		Timeout: InternetGatewayAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.InternetGateway); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	NatGatewayAvailableTimeout = 10 * time.Minute
+
+	NatGatewayDeletedTimeout = 30 * time.Minute
+)
+
+// NatGatewayAvailable waits for a NAT gateway to become available.
+func NatGatewayAvailable(conn *ec2.EC2, id string) (*ec2.NatGateway, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.NatGatewayStatePending},
+		Target:  []string{ec2.NatGatewayStateAvailable},
+		Refresh: NatGatewayState(conn, id),
+		Timeout: NatGatewayAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NatGateway); ok {
+		if err != nil && output.FailureCode != nil {
+			err = fmt.Errorf("%w (failure: %s: %s)", err, aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// NatGatewayDeleted waits for a NAT gateway to be deleted.
+func NatGatewayDeleted(conn *ec2.EC2, id string) (*ec2.NatGateway, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.NatGatewayStateDeleting},
+		Target:     []string{},
+		Refresh:    NatGatewayState(conn, id),
+		Timeout:    NatGatewayDeletedTimeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NatGateway); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	NetworkInterfaceAvailableDelay = 30 * time.Second
+)
+
+// NetworkInterfaceAvailable waits for a network interface to become available.
+func NetworkInterfaceAvailable(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NetworkInterface, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{NetworkInterfaceStatusPending},
+		Target:  []string{ec2.NetworkInterfaceStatusAvailable},
+		Refresh: NetworkInterfaceStatus(conn, id),
+		Timeout: timeout,
+		Delay:   NetworkInterfaceAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NetworkInterface); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	RouteTableReadyTimeout = 10 * time.Minute
+
+	RouteTableDeletedTimeout = 5 * time.Minute
+)
+
+// RouteTableReady waits for a newly created route table to become visible.
+func RouteTableReady(conn *ec2.EC2, id string) (*ec2.RouteTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{},
+		Target:         []string{RouteTableStatusReady},
+		Refresh:        RouteTableStatus(conn, id),
+		Timeout:        RouteTableReadyTimeout,
+		NotFoundChecks: 40,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.RouteTable); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// RouteTableDeleted waits for a route table to be deleted.
+func RouteTableDeleted(conn *ec2.EC2, id string) (*ec2.RouteTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{RouteTableStatusReady},
+		Target:  []string{},
+		Refresh: RouteTableStatus(conn, id),
+		Timeout: RouteTableDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.RouteTable); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SubnetAvailable waits for a subnet to become available.
+func SubnetAvailable(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Subnet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.SubnetStatePending},
+		Target:  []string{ec2.SubnetStateAvailable},
+		Refresh: SubnetState(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Subnet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	VpcAvailableTimeout = 10 * time.Minute
+)
+
+// VpcAvailable waits for a VPC to become available.
+func VpcAvailable(conn *ec2.EC2, id string) (*ec2.Vpc, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VpcStatePending},
+		Target:  []string{ec2.VpcStateAvailable},
+		Refresh: VpcState(conn, id),
+		Timeout: VpcAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Vpc); ok {
+		return output, err
+	}
+
+	return nil, err
+}
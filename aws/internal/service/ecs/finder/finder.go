@@ -0,0 +1,43 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TaskSetByTaskSetIDServiceAndCluster returns the ECS Task Set corresponding to the specified task set ID, service and cluster.
+func TaskSetByTaskSetIDServiceAndCluster(conn *ecs.ECS, taskSetID, service, cluster string) (*ecs.TaskSet, error) {
+	input := &ecs.DescribeTaskSetsInput{
+		Cluster:  aws.String(cluster),
+		Include:  aws.StringSlice([]string{ecs.TaskSetFieldTags}),
+		Service:  aws.String(service),
+		TaskSets: aws.StringSlice([]string{taskSetID}),
+	}
+
+	output, err := conn.DescribeTaskSets(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) ||
+		tfawserr.ErrCodeEquals(err, ecs.ErrCodeServiceNotFoundException) ||
+		tfawserr.ErrCodeEquals(err, ecs.ErrCodeTaskSetNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskSets) == 0 || output.TaskSets[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output.TaskSets[0], nil
+}
@@ -0,0 +1,25 @@
+package ecs
+
+import (
+	"fmt"
+	"strings"
+)
+
+const taskSetResourceIDSeparator = ","
+
+func TaskSetCreateResourceID(taskSetID, service, cluster string) string {
+	parts := []string{taskSetID, service, cluster}
+	id := strings.Join(parts, taskSetResourceIDSeparator)
+
+	return id
+}
+
+func TaskSetParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, taskSetResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected task-set-id%[2]sservice%[2]scluster", id, taskSetResourceIDSeparator)
+}
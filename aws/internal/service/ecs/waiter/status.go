@@ -4,6 +4,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 const (
@@ -34,3 +36,37 @@ func CapacityProviderStatus(conn *ecs.ECS, capacityProvider string) resource.Sta
 		return output.CapacityProviders[0], aws.StringValue(output.CapacityProviders[0].Status), nil
 	}
 }
+
+// TaskSetStabilityStatus fetches the Task Set and its StabilityStatus
+func TaskSetStabilityStatus(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.TaskSetByTaskSetIDServiceAndCluster(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.StabilityStatus), nil
+	}
+}
+
+// TaskSetStatus fetches the Task Set and its Status
+func TaskSetStatus(conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.TaskSetByTaskSetIDServiceAndCluster(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -29,3 +29,45 @@ func CapacityProviderInactive(conn *ecs.ECS, capacityProvider string) (*ecs.Capa
 
 	return nil, err
 }
+
+const (
+	taskSetStatusActive   = "ACTIVE"
+	taskSetStatusDraining = "DRAINING"
+	taskSetStatusPrimary  = "PRIMARY"
+)
+
+// TaskSetStable waits for a Task Set to reach STEADY_STATE
+func TaskSetStable(conn *ecs.ECS, taskSetID, service, cluster string, timeout time.Duration) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecs.StabilityStatusStabilizing},
+		Target:  []string{ecs.StabilityStatusSteadyState},
+		Refresh: TaskSetStabilityStatus(conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// TaskSetDeleted waits for a Task Set to be deleted
+func TaskSetDeleted(conn *ecs.ECS, taskSetID, service, cluster string, timeout time.Duration) (*ecs.TaskSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{taskSetStatusActive, taskSetStatusDraining, taskSetStatusPrimary},
+		Target:  []string{},
+		Refresh: TaskSetStatus(conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.TaskSet); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,71 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// AddonByClusterNameAndAddonName returns the EKS Addon corresponding to the specified cluster and addon names.
+func AddonByClusterNameAndAddonName(conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
+	input := &eks.DescribeAddonInput{
+		AddonName:   aws.String(addonName),
+		ClusterName: aws.String(clusterName),
+	}
+
+	output, err := conn.DescribeAddon(input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Addon == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output.Addon, nil
+}
+
+// AddonUpdateByClusterNameAddonNameAndID returns the EKS Addon update corresponding to the specified cluster and addon names and update ID.
+func AddonUpdateByClusterNameAddonNameAndID(conn *eks.EKS, clusterName, addonName, id string) (*eks.Update, error) {
+	input := &eks.DescribeUpdateInput{
+		AddonName: aws.String(addonName),
+		Name:      aws.String(clusterName),
+		UpdateId:  aws.String(id),
+	}
+
+	output, err := conn.DescribeUpdate(input)
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Update == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output.Update, nil
+}
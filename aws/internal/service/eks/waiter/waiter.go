@@ -0,0 +1,124 @@
+package waiter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// AddonCreated waits for an Addon to return Active
+func AddonCreated(conn *eks.EKS, clusterName, addonName string, timeout time.Duration) (*eks.Addon, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.AddonStatusCreating},
+		Target:  []string{eks.AddonStatusActive},
+		Refresh: AddonStatus(conn, clusterName, addonName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Addon); ok {
+		if status := aws.StringValue(output.Status); status == eks.AddonStatusCreateFailed || status == eks.AddonStatusDegraded {
+			return output, addonHealthError(output.Health, err)
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AddonDeleted waits for an Addon to be deleted
+func AddonDeleted(conn *eks.EKS, clusterName, addonName string, timeout time.Duration) (*eks.Addon, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.AddonStatusActive, eks.AddonStatusDeleting},
+		Target:  []string{},
+		Refresh: AddonStatus(conn, clusterName, addonName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Addon); ok {
+		if status := aws.StringValue(output.Status); status == eks.AddonStatusDeleteFailed {
+			return output, addonHealthError(output.Health, err)
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// AddonUpdateSuccessful waits for an Addon Update to return Successful
+func AddonUpdateSuccessful(conn *eks.EKS, clusterName, addonName, id string, timeout time.Duration) (*eks.Update, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eks.UpdateStatusInProgress},
+		Target:  []string{eks.UpdateStatusSuccessful},
+		Refresh: AddonUpdateStatus(conn, clusterName, addonName, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eks.Update); ok {
+		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
+			return output, updateErrorsError(output.Errors, err)
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// addonHealthError appends the Addon health issues to the waiter error.
+func addonHealthError(health *eks.AddonHealth, err error) error {
+	if health == nil || len(health.Issues) == 0 {
+		return err
+	}
+
+	var issues []string
+
+	for _, issue := range health.Issues {
+		if issue == nil {
+			continue
+		}
+
+		issues = append(issues, fmt.Sprintf("%s: %s", aws.StringValue(issue.Code), aws.StringValue(issue.Message)))
+	}
+
+	if err == nil {
+		return errors.New(strings.Join(issues, "; "))
+	}
+
+	return fmt.Errorf("%w: %s", err, strings.Join(issues, "; "))
+}
+
+// updateErrorsError appends the Update errors to the waiter error.
+func updateErrorsError(apiObjects []*eks.ErrorDetail, err error) error {
+	if len(apiObjects) == 0 {
+		return err
+	}
+
+	var errs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)))
+	}
+
+	if err == nil {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return fmt.Errorf("%w: %s", err, strings.Join(errs, "; "))
+}
@@ -0,0 +1,149 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// GlobalReplicationGroupByID returns the ElastiCache Global Replication Group corresponding to the specified identifier.
+func GlobalReplicationGroupByID(conn *elasticache.ElastiCache, id string) (*elasticache.GlobalReplicationGroup, error) {
+	input := &elasticache.DescribeGlobalReplicationGroupsInput{
+		GlobalReplicationGroupId: aws.String(id),
+		ShowMemberInfo:           aws.Bool(true),
+	}
+
+	output, err := conn.DescribeGlobalReplicationGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.GlobalReplicationGroups) == 0 || output.GlobalReplicationGroups[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output.GlobalReplicationGroups[0], nil
+}
+
+// GlobalReplicationGroupMemberByID returns the member of the specified ElastiCache Global Replication Group
+// corresponding to the specified replication group identifier.
+func GlobalReplicationGroupMemberByID(conn *elasticache.ElastiCache, globalReplicationGroupID string, replicationGroupID string) (*elasticache.GlobalReplicationGroupMember, error) {
+	globalReplicationGroup, err := GlobalReplicationGroupByID(conn, globalReplicationGroupID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range globalReplicationGroup.Members {
+		if aws.StringValue(member.ReplicationGroupId) == replicationGroupID {
+			return member, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: "no matching Global Replication Group member found",
+	}
+}
+
+// ReplicationGroupByID returns the ElastiCache Replication Group corresponding to the specified identifier.
+func ReplicationGroupByID(conn *elasticache.ElastiCache, id string) (*elasticache.ReplicationGroup, error) {
+	input := &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: aws.String(id),
+	}
+
+	output, err := conn.DescribeReplicationGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeReplicationGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ReplicationGroups) == 0 || output.ReplicationGroups[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output.ReplicationGroups[0], nil
+}
+
+// UserByID returns the ElastiCache User corresponding to the specified identifier.
+func UserByID(conn *elasticache.ElastiCache, id string) (*elasticache.User, error) {
+	input := &elasticache.DescribeUsersInput{
+		UserId: aws.String(id),
+	}
+
+	output, err := conn.DescribeUsers(input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Users) == 0 || output.Users[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output.Users[0], nil
+}
+
+// UserGroupByID returns the ElastiCache User Group corresponding to the specified identifier.
+func UserGroupByID(conn *elasticache.ElastiCache, id string) (*elasticache.UserGroup, error) {
+	input := &elasticache.DescribeUserGroupsInput{
+		UserGroupId: aws.String(id),
+	}
+
+	output, err := conn.DescribeUserGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.UserGroups) == 0 || output.UserGroups[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output.UserGroups[0], nil
+}
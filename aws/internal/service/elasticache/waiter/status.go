@@ -0,0 +1,77 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// GlobalReplicationGroupStatus fetches the Global Replication Group and its Status
+func GlobalReplicationGroupStatus(conn *elasticache.ElastiCache, globalReplicationGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, globalReplicationGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return globalReplicationGroup, aws.StringValue(globalReplicationGroup.Status), nil
+	}
+}
+
+// GlobalReplicationGroupMemberStatus fetches the Global Replication Group member and its Status
+func GlobalReplicationGroupMemberStatus(conn *elasticache.ElastiCache, globalReplicationGroupID string, replicationGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		member, err := finder.GlobalReplicationGroupMemberByID(conn, globalReplicationGroupID, replicationGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return member, aws.StringValue(member.Status), nil
+	}
+}
+
+// UserStatus fetches the User and its Status
+func UserStatus(conn *elasticache.ElastiCache, userID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		user, err := finder.UserByID(conn, userID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return user, aws.StringValue(user.Status), nil
+	}
+}
+
+// UserGroupStatus fetches the User Group and its Status
+func UserGroupStatus(conn *elasticache.ElastiCache, userGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		userGroup, err := finder.UserGroupByID(conn, userGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return userGroup, aws.StringValue(userGroup.Status), nil
+	}
+}
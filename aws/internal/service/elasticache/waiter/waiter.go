@@ -0,0 +1,194 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	GlobalReplicationGroupStatusAvailable   = "available"
+	GlobalReplicationGroupStatusCreating    = "creating"
+	GlobalReplicationGroupStatusDeleted     = "deleted"
+	GlobalReplicationGroupStatusDeleting    = "deleting"
+	GlobalReplicationGroupStatusModifying   = "modifying"
+	GlobalReplicationGroupStatusPrimaryOnly = "primary-only"
+
+	GlobalReplicationGroupMemberRolePrimary   = "PRIMARY"
+	GlobalReplicationGroupMemberRoleSecondary = "SECONDARY"
+
+	GlobalReplicationGroupMemberStatusAssociated     = "associated"
+	GlobalReplicationGroupMemberStatusDisassociated  = "disassociated"
+	GlobalReplicationGroupMemberStatusDisassociating = "disassociating"
+
+	UserStatusActive    = "active"
+	UserStatusDeleting  = "deleting"
+	UserStatusModifying = "modifying"
+
+	UserGroupStatusActive    = "active"
+	UserGroupStatusCreating  = "creating"
+	UserGroupStatusDeleting  = "deleting"
+	UserGroupStatusModifying = "modifying"
+)
+
+const (
+	GlobalReplicationGroupDefaultCreatedTimeout = 20 * time.Minute
+	GlobalReplicationGroupDefaultUpdatedTimeout = 40 * time.Minute
+	GlobalReplicationGroupDefaultDeletedTimeout = 20 * time.Minute
+
+	GlobalReplicationGroupMemberDetachedTimeout = 10 * time.Minute
+
+	UserActiveTimeout  = 5 * time.Minute
+	UserDeletedTimeout = 5 * time.Minute
+
+	UserGroupActiveTimeout  = 10 * time.Minute
+	UserGroupDeletedTimeout = 10 * time.Minute
+
+	globalReplicationGroupAvailableMinTimeout = 10 * time.Second
+	globalReplicationGroupAvailableDelay      = 30 * time.Second
+
+	globalReplicationGroupDeletedMinTimeout = 10 * time.Second
+	globalReplicationGroupDeletedDelay      = 30 * time.Second
+)
+
+// GlobalReplicationGroupAvailable waits for a Global Replication Group to be available,
+// with status either "available" or "primary-only"
+func GlobalReplicationGroupAvailable(conn *elasticache.ElastiCache, globalReplicationGroupID string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{GlobalReplicationGroupStatusCreating, GlobalReplicationGroupStatusModifying},
+		Target:     []string{GlobalReplicationGroupStatusAvailable, GlobalReplicationGroupStatusPrimaryOnly},
+		Refresh:    GlobalReplicationGroupStatus(conn, globalReplicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupAvailableMinTimeout,
+		Delay:      globalReplicationGroupAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// GlobalReplicationGroupDeleted waits for a Global Replication Group to be deleted
+func GlobalReplicationGroupDeleted(conn *elasticache.ElastiCache, globalReplicationGroupID string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalReplicationGroupStatusAvailable,
+			GlobalReplicationGroupStatusPrimaryOnly,
+			GlobalReplicationGroupStatusModifying,
+			GlobalReplicationGroupStatusDeleting,
+		},
+		Target:     []string{},
+		Refresh:    GlobalReplicationGroupStatus(conn, globalReplicationGroupID),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupDeletedMinTimeout,
+		Delay:      globalReplicationGroupDeletedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// GlobalReplicationGroupMemberDetached waits for a Replication Group to be detached from a Global Replication Group
+func GlobalReplicationGroupMemberDetached(conn *elasticache.ElastiCache, globalReplicationGroupID string, replicationGroupID string) (*elasticache.GlobalReplicationGroupMember, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalReplicationGroupMemberStatusAssociated,
+			GlobalReplicationGroupMemberStatusDisassociating,
+		},
+		Target:     []string{},
+		Refresh:    GlobalReplicationGroupMemberStatus(conn, globalReplicationGroupID, replicationGroupID),
+		Timeout:    GlobalReplicationGroupMemberDetachedTimeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// UserActive waits for a User to return "active"
+func UserActive(conn *elasticache.ElastiCache, userID string) (*elasticache.User, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusModifying},
+		Target:  []string{UserStatusActive},
+		Refresh: UserStatus(conn, userID),
+		Timeout: UserActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.User); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// UserDeleted waits for a User to be deleted
+func UserDeleted(conn *elasticache.ElastiCache, userID string) (*elasticache.User, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserStatusDeleting},
+		Target:  []string{},
+		Refresh: UserStatus(conn, userID),
+		Timeout: UserDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.User); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// UserGroupActive waits for a User Group to return "active"
+func UserGroupActive(conn *elasticache.ElastiCache, userGroupID string) (*elasticache.UserGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserGroupStatusCreating, UserGroupStatusModifying},
+		Target:  []string{UserGroupStatusActive},
+		Refresh: UserGroupStatus(conn, userGroupID),
+		Timeout: UserGroupActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.UserGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// UserGroupDeleted waits for a User Group to be deleted
+func UserGroupDeleted(conn *elasticache.ElastiCache, userGroupID string) (*elasticache.UserGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{UserGroupStatusDeleting},
+		Target:  []string{},
+		Refresh: UserGroupStatus(conn, userGroupID),
+		Timeout: UserGroupDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.UserGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
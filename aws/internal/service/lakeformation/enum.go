@@ -0,0 +1,10 @@
+package lakeformation
+
+const (
+	// TableNameAllTables is the table name returned by the API for permissions
+	// granted with a table wildcard
+	TableNameAllTables = "ALL_TABLES"
+
+	TableTypeTable            = "Table"
+	TableTypeTableWithColumns = "TableWithColumns"
+)
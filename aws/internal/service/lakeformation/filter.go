@@ -0,0 +1,248 @@
+package lakeformation
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+)
+
+// FilterPermissions returns the subset of allPermissions that belong to the
+// principal and resource described by input.
+//
+// ListPermissions returns more than was asked for, e.g. permissions on every
+// table in a database, and SELECT grants on a table are reported against the
+// TableWithColumns resource. Table with columns grants cannot be listed
+// directly, so callers list them as a Table and pass tableType along with the
+// column details to filter the results.
+func FilterPermissions(input *lakeformation.ListPermissionsInput, tableType string, columnNames []*string, excludedColumnNames []*string, columnWildcard bool, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	// For most Lake Formation resources, filtering within the provider is unnecessary. The input
+	// contains everything for AWS to give you back exactly what you want. However, many times the
+	// table and table with columns resources are handled differently than expected.
+	principalPermissions := filterPermissionsByPrincipal(input.Principal, allPermissions)
+
+	if input.Resource == nil {
+		return principalPermissions
+	}
+
+	if tableType == TableTypeTableWithColumns && input.Resource.Table != nil {
+		return FilterTableWithColumnsPermissions(input.Resource.Table, columnNames, excludedColumnNames, columnWildcard, principalPermissions)
+	}
+
+	if input.Resource.Table != nil {
+		return FilterTablePermissions(input.Resource.Table, principalPermissions)
+	}
+
+	if input.Resource.Catalog != nil {
+		return FilterCatalogPermissions(principalPermissions)
+	}
+
+	if input.Resource.DataLocation != nil {
+		return FilterDataLocationPermissions(input.Resource.DataLocation, principalPermissions)
+	}
+
+	if input.Resource.Database != nil {
+		return FilterDatabasePermissions(input.Resource.Database, principalPermissions)
+	}
+
+	return principalPermissions
+}
+
+// FilterCatalogPermissions returns permissions granted on the Data Catalog itself.
+func FilterCatalogPermissions(allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if perm.Resource != nil && perm.Resource.Catalog != nil {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+// FilterDataLocationPermissions returns permissions granted on the given data location.
+func FilterDataLocationPermissions(dataLocation *lakeformation.DataLocationResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if perm.Resource == nil || perm.Resource.DataLocation == nil {
+			continue
+		}
+
+		if aws.StringValue(perm.Resource.DataLocation.ResourceArn) == aws.StringValue(dataLocation.ResourceArn) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+// FilterDatabasePermissions returns permissions granted on the given database.
+func FilterDatabasePermissions(database *lakeformation.DatabaseResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if perm.Resource == nil || perm.Resource.Database == nil {
+			continue
+		}
+
+		if aws.StringValue(perm.Resource.Database.Name) == aws.StringValue(database.Name) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+// FilterTablePermissions returns permissions granted on the given table or, if
+// the table has a wildcard, on all tables in its database.
+func FilterTablePermissions(table *lakeformation.TableResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	// CREATE PERMS = ALL, ALTER, DELETE, DESCRIBE, DROP, INSERT, SELECT on Table, Name = (Table Name)
+	//   LIST PERMS = ALL, ALTER, DELETE, DESCRIBE, DROP, INSERT         on Table, Name = (Table Name)
+	//   LIST PERMS = SELECT                                             on TableWithColumns, Name = (Table Name), ColumnWildcard
+
+	// CREATE PERMS = ALL, ALTER, DELETE, DESCRIBE, DROP, INSERT, SELECT on Table, TableWildcard
+	//   LIST PERMS = ALL, ALTER, DELETE, DESCRIBE, DROP, INSERT         on Table, TableWildcard, Name = ALL_TABLES
+	//   LIST PERMS = SELECT                                             on TableWithColumns, Name = ALL_TABLES, ColumnWildcard
+
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if perm.Resource == nil {
+			continue
+		}
+
+		if twc := perm.Resource.TableWithColumns; twc != nil && twc.ColumnWildcard != nil && len(twc.ColumnWildcard.ExcludedColumnNames) == 0 {
+			if aws.StringValue(twc.DatabaseName) != aws.StringValue(table.DatabaseName) {
+				continue
+			}
+
+			if aws.StringValue(twc.Name) == aws.StringValue(table.Name) || (table.TableWildcard != nil && aws.StringValue(twc.Name) == TableNameAllTables) {
+				if isSelectOnly(perm) {
+					cleanPermissions = append(cleanPermissions, perm)
+				}
+			}
+
+			continue
+		}
+
+		if t := perm.Resource.Table; t != nil && aws.StringValue(t.DatabaseName) == aws.StringValue(table.DatabaseName) {
+			if table.TableWildcard != nil {
+				if t.TableWildcard != nil || aws.StringValue(t.Name) == TableNameAllTables {
+					cleanPermissions = append(cleanPermissions, perm)
+				}
+
+				continue
+			}
+
+			if aws.StringValue(t.Name) == aws.StringValue(table.Name) {
+				cleanPermissions = append(cleanPermissions, perm)
+			}
+		}
+	}
+
+	return cleanPermissions
+}
+
+// FilterTableWithColumnsPermissions returns permissions granted on the columns
+// of the given table matching either the column names, the excluded column
+// names or the column wildcard.
+func FilterTableWithColumnsPermissions(table *lakeformation.TableResource, columnNames []*string, excludedColumnNames []*string, columnWildcard bool, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	// CREATE PERMS = ALL, ALTER, DELETE, DESCRIBE, DROP, INSERT, SELECT on TableWithColumns, Name = (Table Name), ColumnWildcard
+	//   LIST PERMS = ALL, ALTER, DELETE, DESCRIBE, DROP, INSERT         on Table, Name = (Table Name)
+	//   LIST PERMS = SELECT                                             on TableWithColumns, Name = (Table Name), ColumnWildcard
+
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if perm.Resource == nil {
+			continue
+		}
+
+		if twc := perm.Resource.TableWithColumns; twc != nil {
+			if aws.StringValue(twc.DatabaseName) != aws.StringValue(table.DatabaseName) || aws.StringValue(twc.Name) != aws.StringValue(table.Name) {
+				continue
+			}
+
+			if len(columnNames) > 0 && twc.ColumnNames != nil && StringSlicesEqualIgnoreOrder(twc.ColumnNames, columnNames) {
+				cleanPermissions = append(cleanPermissions, perm)
+				continue
+			}
+
+			if (columnWildcard || len(excludedColumnNames) > 0) && twc.ColumnWildcard != nil &&
+				StringSlicesEqualIgnoreOrder(twc.ColumnWildcard.ExcludedColumnNames, excludedColumnNames) {
+				cleanPermissions = append(cleanPermissions, perm)
+			}
+
+			continue
+		}
+
+		// Non-SELECT permissions granted with a column wildcard are reported on the table
+		if t := perm.Resource.Table; t != nil && columnWildcard && len(excludedColumnNames) == 0 {
+			if aws.StringValue(t.DatabaseName) == aws.StringValue(table.DatabaseName) && aws.StringValue(t.Name) == aws.StringValue(table.Name) {
+				cleanPermissions = append(cleanPermissions, perm)
+			}
+		}
+	}
+
+	return cleanPermissions
+}
+
+// StringSlicesEqualIgnoreOrder reports whether s1 and s2 contain the same
+// strings, regardless of order.
+func StringSlicesEqualIgnoreOrder(s1, s2 []*string) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+
+	v1 := aws.StringValueSlice(s1)
+	v2 := aws.StringValueSlice(s2)
+
+	sort.Strings(v1)
+	sort.Strings(v2)
+
+	for i := range v1 {
+		if v1[i] != v2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func filterPermissionsByPrincipal(principal *lakeformation.DataLakePrincipal, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	if principal == nil {
+		return allPermissions
+	}
+
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if perm.Principal == nil {
+			continue
+		}
+
+		if aws.StringValue(perm.Principal.DataLakePrincipalIdentifier) == aws.StringValue(principal.DataLakePrincipalIdentifier) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+func isSelectOnly(perm *lakeformation.PrincipalResourcePermissions) bool {
+	for _, v := range perm.Permissions {
+		if aws.StringValue(v) != lakeformation.PermissionSelect {
+			return false
+		}
+	}
+
+	for _, v := range perm.PermissionsWithGrantOption {
+		if aws.StringValue(v) != lakeformation.PermissionSelect {
+			return false
+		}
+	}
+
+	return len(perm.Permissions) > 0 || len(perm.PermissionsWithGrantOption) > 0
+}
@@ -0,0 +1,223 @@
+package lakeformation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+)
+
+const testPrincipal = "arn:aws:iam::123456789012:role/test"
+
+func testPermission(principal string, resource *lakeformation.Resource, permissions ...string) *lakeformation.PrincipalResourcePermissions {
+	return &lakeformation.PrincipalResourcePermissions{
+		Permissions: aws.StringSlice(permissions),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+		Resource: resource,
+	}
+}
+
+func TestFilterPermissions(t *testing.T) {
+	principal := &lakeformation.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(testPrincipal),
+	}
+
+	tableResource := &lakeformation.Resource{
+		Table: &lakeformation.TableResource{
+			DatabaseName: aws.String("db"),
+			Name:         aws.String("tbl"),
+		},
+	}
+
+	otherTableResource := &lakeformation.Resource{
+		Table: &lakeformation.TableResource{
+			DatabaseName: aws.String("db"),
+			Name:         aws.String("other"),
+		},
+	}
+
+	allTablesResource := &lakeformation.Resource{
+		Table: &lakeformation.TableResource{
+			DatabaseName:  aws.String("db"),
+			Name:          aws.String(TableNameAllTables),
+			TableWildcard: &lakeformation.TableWildcard{},
+		},
+	}
+
+	columnWildcardResource := &lakeformation.Resource{
+		TableWithColumns: &lakeformation.TableWithColumnsResource{
+			ColumnWildcard: &lakeformation.ColumnWildcard{},
+			DatabaseName:   aws.String("db"),
+			Name:           aws.String("tbl"),
+		},
+	}
+
+	excludedColumnsResource := &lakeformation.Resource{
+		TableWithColumns: &lakeformation.TableWithColumnsResource{
+			ColumnWildcard: &lakeformation.ColumnWildcard{
+				ExcludedColumnNames: aws.StringSlice([]string{"c3"}),
+			},
+			DatabaseName: aws.String("db"),
+			Name:         aws.String("tbl"),
+		},
+	}
+
+	columnNamesResource := &lakeformation.Resource{
+		TableWithColumns: &lakeformation.TableWithColumnsResource{
+			ColumnNames:  aws.StringSlice([]string{"c2", "c1"}),
+			DatabaseName: aws.String("db"),
+			Name:         aws.String("tbl"),
+		},
+	}
+
+	databaseResource := &lakeformation.Resource{
+		Database: &lakeformation.DatabaseResource{
+			Name: aws.String("db"),
+		},
+	}
+
+	dataLocationResource := &lakeformation.Resource{
+		DataLocation: &lakeformation.DataLocationResource{
+			ResourceArn: aws.String("arn:aws:s3:::bucket"),
+		},
+	}
+
+	allPermissions := []*lakeformation.PrincipalResourcePermissions{
+		testPermission(testPrincipal, tableResource, lakeformation.PermissionAlter),                              // 0
+		testPermission(testPrincipal, otherTableResource, lakeformation.PermissionAlter),                         // 1
+		testPermission(testPrincipal, allTablesResource, lakeformation.PermissionDescribe),                       // 2
+		testPermission(testPrincipal, columnWildcardResource, lakeformation.PermissionSelect),                    // 3
+		testPermission(testPrincipal, excludedColumnsResource, lakeformation.PermissionSelect),                   // 4
+		testPermission(testPrincipal, columnNamesResource, lakeformation.PermissionSelect),                       // 5
+		testPermission(testPrincipal, databaseResource, lakeformation.PermissionCreateTable),                     // 6
+		testPermission(testPrincipal, dataLocationResource, lakeformation.PermissionDataLocationAccess),          // 7
+		testPermission("arn:aws:iam::123456789012:role/other", tableResource, lakeformation.PermissionAlter),     // 8
+		testPermission(testPrincipal, &lakeformation.Resource{Catalog: &lakeformation.CatalogResource{}}, "ALL"), // 9
+	}
+
+	testCases := []struct {
+		Name                string
+		Input               *lakeformation.ListPermissionsInput
+		TableType           string
+		ColumnNames         []string
+		ExcludedColumnNames []string
+		ColumnWildcard      bool
+		Expected            []int
+	}{
+		{
+			Name: "catalog",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource:  &lakeformation.Resource{Catalog: &lakeformation.CatalogResource{}},
+			},
+			Expected: []int{9},
+		},
+		{
+			Name: "data location",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource:  dataLocationResource,
+			},
+			Expected: []int{7},
+		},
+		{
+			Name: "database",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource:  databaseResource,
+			},
+			Expected: []int{6},
+		},
+		{
+			Name: "table",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource:  tableResource,
+			},
+			TableType: TableTypeTable,
+			Expected:  []int{0, 3},
+		},
+		{
+			Name: "table wildcard",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource: &lakeformation.Resource{
+					Table: &lakeformation.TableResource{
+						DatabaseName:  aws.String("db"),
+						TableWildcard: &lakeformation.TableWildcard{},
+					},
+				},
+			},
+			TableType: TableTypeTable,
+			Expected:  []int{2},
+		},
+		{
+			Name: "table with columns names",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource:  tableResource,
+			},
+			TableType:   TableTypeTableWithColumns,
+			ColumnNames: []string{"c1", "c2"},
+			Expected:    []int{5},
+		},
+		{
+			Name: "table with columns wildcard",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource:  tableResource,
+			},
+			TableType:      TableTypeTableWithColumns,
+			ColumnWildcard: true,
+			Expected:       []int{0, 3},
+		},
+		{
+			Name: "table with columns excluded",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource:  tableResource,
+			},
+			TableType:           TableTypeTableWithColumns,
+			ExcludedColumnNames: []string{"c3"},
+			Expected:            []int{4},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := FilterPermissions(testCase.Input, testCase.TableType, aws.StringSlice(testCase.ColumnNames), aws.StringSlice(testCase.ExcludedColumnNames), testCase.ColumnWildcard, allPermissions)
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("expected %d permissions, got %d: %v", len(testCase.Expected), len(got), got)
+			}
+
+			for i, idx := range testCase.Expected {
+				if got[i] != allPermissions[idx] {
+					t.Errorf("expected permission %d to be %v, got %v", i, allPermissions[idx], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStringSlicesEqualIgnoreOrder(t *testing.T) {
+	testCases := []struct {
+		S1       []string
+		S2       []string
+		Expected bool
+	}{
+		{nil, nil, true},
+		{[]string{}, nil, true},
+		{[]string{"a", "b"}, []string{"b", "a"}, true},
+		{[]string{"a"}, []string{"a", "b"}, false},
+		{[]string{"a", "c"}, []string{"a", "b"}, false},
+	}
+
+	for i, testCase := range testCases {
+		if got := StringSlicesEqualIgnoreOrder(aws.StringSlice(testCase.S1), aws.StringSlice(testCase.S2)); got != testCase.Expected {
+			t.Errorf("test case %d: expected %t, got %t", i, testCase.Expected, got)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tflakeformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation"
+)
+
+const (
+	StatusAvailable = "AVAILABLE"
+	StatusNotFound  = "NOT FOUND"
+	StatusFailed    = "FAILED"
+)
+
+// PermissionsStatus fetches the Lake Formation Permissions matching the input and returns them
+// along with whether any were found
+func PermissionsStatus(conn *lakeformation.LakeFormation, input *lakeformation.ListPermissionsInput, tableType string, columnNames []*string, excludedColumnNames []*string, columnWildcard bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var permissions []*lakeformation.PrincipalResourcePermissions
+
+		err := conn.ListPermissionsPages(input, func(resp *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+			for _, permission := range resp.PrincipalResourcePermissions {
+				if permission == nil {
+					continue
+				}
+
+				permissions = append(permissions, permission)
+			}
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, StatusFailed, err
+		}
+
+		// clean permissions = filter out permissions that do not pertain to this specific resource
+		cleanPermissions := tflakeformation.FilterPermissions(input, tableType, columnNames, excludedColumnNames, columnWildcard, permissions)
+
+		if len(cleanPermissions) == 0 {
+			return cleanPermissions, StatusNotFound, nil
+		}
+
+		return cleanPermissions, StatusAvailable, nil
+	}
+}
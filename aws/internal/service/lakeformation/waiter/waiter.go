@@ -0,0 +1,34 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for newly granted Permissions to be listed
+	PermissionsReadyTimeout = 1 * time.Minute
+
+	PermissionsDeleteRetryTimeout = 30 * time.Second
+)
+
+// PermissionsReady waits for the Lake Formation Permissions matching the input to be listed
+func PermissionsReady(conn *lakeformation.LakeFormation, input *lakeformation.ListPermissionsInput, tableType string, columnNames []*string, excludedColumnNames []*string, columnWildcard bool) ([]*lakeformation.PrincipalResourcePermissions, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{StatusNotFound},
+		Target:                    []string{StatusAvailable},
+		Refresh:                   PermissionsStatus(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard),
+		Timeout:                   PermissionsReadyTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.([]*lakeformation.PrincipalResourcePermissions); ok {
+		return v, err
+	}
+
+	return nil, err
+}
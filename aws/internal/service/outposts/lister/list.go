@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/listpages/main.go -function=GetOutpostInstanceTypes github.com/aws/aws-sdk-go/service/outposts
+
+package lister
@@ -0,0 +1,31 @@
+// Code generated by "aws/internal/generators/listpages/main.go -function=GetOutpostInstanceTypes github.com/aws/aws-sdk-go/service/outposts"; DO NOT EDIT.
+
+package lister
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
+)
+
+func GetOutpostInstanceTypesPages(conn *outposts.Outposts, input *outposts.GetOutpostInstanceTypesInput, fn func(*outposts.GetOutpostInstanceTypesOutput, bool) bool) error {
+	return GetOutpostInstanceTypesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func GetOutpostInstanceTypesPagesWithContext(ctx context.Context, conn *outposts.Outposts, input *outposts.GetOutpostInstanceTypesInput, fn func(*outposts.GetOutpostInstanceTypesOutput, bool) bool) error {
+	for {
+		output, err := conn.GetOutpostInstanceTypesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package route53
+
+const (
+	KeySigningKeyStatusActionNeeded    = "ACTION_NEEDED"
+	KeySigningKeyStatusActive          = "ACTIVE"
+	KeySigningKeyStatusDeleting        = "DELETING"
+	KeySigningKeyStatusInactive        = "INACTIVE"
+	KeySigningKeyStatusInternalFailure = "INTERNAL_FAILURE"
+)
+
+func KeySigningKeyStatus_Values() []string {
+	return []string{
+		KeySigningKeyStatusActive,
+		KeySigningKeyStatusInactive,
+	}
+}
+
+const (
+	ServeSignatureActionNeeded    = "ACTION_NEEDED"
+	ServeSignatureDeleting        = "DELETING"
+	ServeSignatureInternalFailure = "INTERNAL_FAILURE"
+	ServeSignatureNotSigning      = "NOT_SIGNING"
+	ServeSignatureSigning         = "SIGNING"
+)
+
+func ServeSignature_Values() []string {
+	return []string{
+		ServeSignatureNotSigning,
+		ServeSignatureSigning,
+	}
+}
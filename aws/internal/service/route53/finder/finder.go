@@ -0,0 +1,75 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfroute53 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53"
+)
+
+// HostedZoneDnssec returns the DNSSEC signing status and key-signing keys of the specified hosted zone.
+func HostedZoneDnssec(conn *route53.Route53, hostedZoneID string) (*route53.GetDNSSECOutput, error) {
+	input := &route53.GetDNSSECInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+
+	output, err := conn.GetDNSSEC(input)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned empty response",
+		}
+	}
+
+	return output, nil
+}
+
+// KeySigningKey returns the key-signing key with the specified name in the specified hosted zone.
+func KeySigningKey(conn *route53.Route53, hostedZoneID string, name string) (*route53.KeySigningKey, error) {
+	output, err := HostedZoneDnssec(conn, hostedZoneID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, keySigningKey := range output.KeySigningKeys {
+		if keySigningKey == nil {
+			continue
+		}
+
+		if aws.StringValue(keySigningKey.Name) == name {
+			return keySigningKey, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest:  &route53.GetDNSSECInput{HostedZoneId: aws.String(hostedZoneID)},
+		LastResponse: output,
+		Message:      "no matching key-signing key found",
+	}
+}
+
+// KeySigningKeyByResourceID returns the key-signing key corresponding to the specified Terraform resource ID.
+func KeySigningKeyByResourceID(conn *route53.Route53, resourceID string) (*route53.KeySigningKey, error) {
+	hostedZoneID, name, err := tfroute53.KeySigningKeyParseResourceID(resourceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return KeySigningKey(conn, hostedZoneID, name)
+}
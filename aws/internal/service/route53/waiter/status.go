@@ -0,0 +1,64 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ChangeInfoStatus fetches the Route 53 change and its status
+func ChangeInfoStatus(conn *route53.Route53, changeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &route53.GetChangeInput{
+			Id: aws.String(changeID),
+		}
+
+		output, err := conn.GetChange(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ChangeInfo == nil {
+			return nil, "", nil
+		}
+
+		return output.ChangeInfo, aws.StringValue(output.ChangeInfo.Status), nil
+	}
+}
+
+// HostedZoneDnssecStatus fetches the hosted zone DNSSEC status and its serve signature
+func HostedZoneDnssecStatus(conn *route53.Route53, hostedZoneID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.HostedZoneDnssec(conn, hostedZoneID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output.Status, aws.StringValue(output.Status.ServeSignature), nil
+	}
+}
+
+// KeySigningKeyStatus fetches the key-signing key and its status
+func KeySigningKeyStatus(conn *route53.Route53, hostedZoneID string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.KeySigningKey(conn, hostedZoneID, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
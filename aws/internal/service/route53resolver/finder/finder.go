@@ -5,6 +5,35 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 )
 
+// ResolverDnssecConfigByID returns the DNSSEC validation configuration corresponding to the specified ID.
+// Returns nil if no configuration is found.
+func ResolverDnssecConfigByID(conn *route53resolver.Route53Resolver, dnssecConfigID string) (*route53resolver.ResolverDnssecConfig, error) {
+	input := &route53resolver.ListResolverDnssecConfigsInput{}
+
+	var config *route53resolver.ResolverDnssecConfig
+	// GetResolverDnssecConfig does not support query by ID.
+	err := conn.ListResolverDnssecConfigsPages(input, func(page *route53resolver.ListResolverDnssecConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResolverDnssecConfigs {
+			if aws.StringValue(v.Id) == dnssecConfigID {
+				config = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
 // ResolverQueryLogConfigAssociationByID returns the query logging configuration association corresponding to the specified ID.
 // Returns nil if no configuration is found.
 func ResolverQueryLogConfigAssociationByID(conn *route53resolver.Route53Resolver, queryLogConfigAssociationID string) (*route53resolver.ResolverQueryLogConfigAssociation, error) {
@@ -9,6 +9,9 @@ import (
 )
 
 const (
+	resolverDnssecConfigStatusNotFound = "NotFound"
+	resolverDnssecConfigStatusUnknown  = "Unknown"
+
 	resolverQueryLogConfigAssociationStatusNotFound = "NotFound"
 	resolverQueryLogConfigAssociationStatusUnknown  = "Unknown"
 
@@ -16,6 +19,27 @@ const (
 	resolverQueryLogConfigStatusUnknown  = "Unknown"
 )
 
+// DnssecConfigStatus fetches the DnssecConfig and its Status
+func DnssecConfigStatus(conn *route53resolver.Route53Resolver, dnssecConfigID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		dnssecConfig, err := finder.ResolverDnssecConfigByID(conn, dnssecConfigID)
+
+		if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+			return nil, resolverDnssecConfigStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, resolverDnssecConfigStatusUnknown, err
+		}
+
+		if dnssecConfig == nil {
+			return nil, resolverDnssecConfigStatusNotFound, nil
+		}
+
+		return dnssecConfig, aws.StringValue(dnssecConfig.ValidationStatus), nil
+	}
+}
+
 // QueryLogConfigAssociationStatus fetches the QueryLogConfigAssociation and its Status
 func QueryLogConfigAssociationStatus(conn *route53resolver.Route53Resolver, queryLogConfigAssociationID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -0,0 +1,289 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+)
+
+// BucketAcl returns the access control list of a bucket.
+// Returns a resource.NotFoundError if no bucket is found.
+func BucketAcl(conn *s3.S3, bucketName string) (*s3.GetBucketAclOutput, error) {
+	input := &s3.GetBucketAclInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	output, err := conn.GetBucketAcl(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output, nil
+}
+
+// BucketCorsRules returns the CORS rules of a bucket.
+// Returns a resource.NotFoundError if no bucket or CORS configuration is found.
+func BucketCorsRules(conn *s3.S3, bucketName string) ([]*s3.CORSRule, error) {
+	input := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	output, err := conn.GetBucketCors(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchCORSConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CORSRules) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.CORSRules, nil
+}
+
+// BucketIntelligentTieringConfiguration returns the S3 Intelligent-Tiering configuration of a bucket.
+// Returns a resource.NotFoundError if no bucket or configuration is found.
+func BucketIntelligentTieringConfiguration(conn *s3.S3, bucketName, configurationName string) (*s3.IntelligentTieringConfiguration, error) {
+	input := &s3.GetBucketIntelligentTieringConfigurationInput{
+		Bucket: aws.String(bucketName),
+		Id:     aws.String(configurationName),
+	}
+
+	output, err := conn.GetBucketIntelligentTieringConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IntelligentTieringConfiguration == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.IntelligentTieringConfiguration, nil
+}
+
+// BucketLifecycleRules returns the lifecycle configuration rules of a bucket.
+// Returns a resource.NotFoundError if no bucket or lifecycle configuration is found.
+func BucketLifecycleRules(conn *s3.S3, bucketName string) ([]*s3.LifecycleRule, error) {
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	output, err := conn.GetBucketLifecycleConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchLifecycleConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Rules) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.Rules, nil
+}
+
+// BucketLoggingEnabled returns the access logging configuration of a bucket.
+// Returns a resource.NotFoundError if no bucket is found or access logging is disabled.
+func BucketLoggingEnabled(conn *s3.S3, bucketName string) (*s3.LoggingEnabled, error) {
+	input := &s3.GetBucketLoggingInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	output, err := conn.GetBucketLogging(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LoggingEnabled == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.LoggingEnabled, nil
+}
+
+// BucketReplicationConfiguration returns the replication configuration of a bucket.
+// Returns a resource.NotFoundError if no bucket or replication configuration is found.
+func BucketReplicationConfiguration(conn *s3.S3, bucketName string) (*s3.ReplicationConfiguration, error) {
+	input := &s3.GetBucketReplicationInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	output, err := conn.GetBucketReplication(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeReplicationConfigurationNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReplicationConfiguration == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.ReplicationConfiguration, nil
+}
+
+// BucketServerSideEncryptionConfiguration returns the default encryption configuration of a bucket.
+// Returns a resource.NotFoundError if no bucket or encryption configuration is found.
+func BucketServerSideEncryptionConfiguration(conn *s3.S3, bucketName string) (*s3.ServerSideEncryptionConfiguration, error) {
+	input := &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	output, err := conn.GetBucketEncryption(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeServerSideEncryptionConfigurationNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServerSideEncryptionConfiguration == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output.ServerSideEncryptionConfiguration, nil
+}
+
+// BucketVersioning returns the versioning state of a bucket.
+// Returns a resource.NotFoundError if no bucket is found.
+func BucketVersioning(conn *s3.S3, bucketName string) (*s3.GetBucketVersioningOutput, error) {
+	input := &s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	output, err := conn.GetBucketVersioning(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output, nil
+}
+
+// BucketWebsite returns the website configuration of a bucket.
+// Returns a resource.NotFoundError if no bucket or website configuration is found.
+func BucketWebsite(conn *s3.S3, bucketName string) (*s3.GetBucketWebsiteOutput, error) {
+	input := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	}
+
+	output, err := conn.GetBucketWebsite(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchWebsiteConfiguration) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest:  input,
+			LastResponse: output,
+			Message:      "returned no results",
+		}
+	}
+
+	return output, nil
+}
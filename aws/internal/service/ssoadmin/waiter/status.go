@@ -0,0 +1,94 @@
+package waiter
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	AccountAssignmentStatusUnknown         = "Unknown"
+	PermissionSetProvisioningStatusUnknown = "Unknown"
+)
+
+// AccountAssignmentCreationStatus fetches the Account Assignment creation request and its Status
+func AccountAssignmentCreationStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentCreationStatusInput{
+			AccountAssignmentCreationRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		resp, err := conn.DescribeAccountAssignmentCreationStatus(input)
+
+		if err != nil {
+			return nil, AccountAssignmentStatusUnknown, err
+		}
+
+		if resp == nil || resp.AccountAssignmentCreationStatus == nil {
+			return nil, AccountAssignmentStatusUnknown, nil
+		}
+
+		return accountAssignmentOperationStatus(resp.AccountAssignmentCreationStatus)
+	}
+}
+
+// AccountAssignmentDeletionStatus fetches the Account Assignment deletion request and its Status
+func AccountAssignmentDeletionStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentDeletionStatusInput{
+			AccountAssignmentDeletionRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		resp, err := conn.DescribeAccountAssignmentDeletionStatus(input)
+
+		if err != nil {
+			return nil, AccountAssignmentStatusUnknown, err
+		}
+
+		if resp == nil || resp.AccountAssignmentDeletionStatus == nil {
+			return nil, AccountAssignmentStatusUnknown, nil
+		}
+
+		return accountAssignmentOperationStatus(resp.AccountAssignmentDeletionStatus)
+	}
+}
+
+// PermissionSetProvisioningStatus fetches the Permission Set provisioning request and its Status
+func PermissionSetProvisioningStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribePermissionSetProvisioningStatusInput{
+			InstanceArn:                     aws.String(instanceArn),
+			ProvisionPermissionSetRequestId: aws.String(requestID),
+		}
+
+		resp, err := conn.DescribePermissionSetProvisioningStatus(input)
+
+		if err != nil {
+			return nil, PermissionSetProvisioningStatusUnknown, err
+		}
+
+		if resp == nil || resp.PermissionSetProvisioningStatus == nil {
+			return nil, PermissionSetProvisioningStatusUnknown, nil
+		}
+
+		status := resp.PermissionSetProvisioningStatus
+
+		if aws.StringValue(status.Status) == ssoadmin.StatusValuesFailed {
+			return status, aws.StringValue(status.Status), fmt.Errorf("%s", aws.StringValue(status.FailureReason))
+		}
+
+		return status, aws.StringValue(status.Status), nil
+	}
+}
+
+func accountAssignmentOperationStatus(status *ssoadmin.AccountAssignmentOperationStatus) (interface{}, string, error) {
+	if aws.StringValue(status.Status) == ssoadmin.StatusValuesFailed {
+		return status, aws.StringValue(status.Status), fmt.Errorf("%s", aws.StringValue(status.FailureReason))
+	}
+
+	return status, aws.StringValue(status.Status), nil
+}
@@ -0,0 +1,82 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an Account Assignment to be created or deleted
+	AccountAssignmentTimeout = 5 * time.Minute
+
+	AccountAssignmentDelay      = 5 * time.Second
+	AccountAssignmentMinTimeout = 3 * time.Second
+
+	// Maximum amount of time to wait for a Permission Set to be provisioned
+	PermissionSetProvisioningTimeout = 10 * time.Minute
+
+	PermissionSetProvisioningDelay      = 5 * time.Second
+	PermissionSetProvisioningMinTimeout = 3 * time.Second
+)
+
+// AccountAssignmentCreated waits for an Account Assignment creation request to return SUCCEEDED
+func AccountAssignmentCreated(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    AccountAssignmentCreationStatus(conn, instanceArn, requestID),
+		Timeout:    AccountAssignmentTimeout,
+		Delay:      AccountAssignmentDelay,
+		MinTimeout: AccountAssignmentMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// AccountAssignmentDeleted waits for an Account Assignment deletion request to return SUCCEEDED
+func AccountAssignmentDeleted(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    AccountAssignmentDeletionStatus(conn, instanceArn, requestID),
+		Timeout:    AccountAssignmentTimeout,
+		Delay:      AccountAssignmentDelay,
+		MinTimeout: AccountAssignmentMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// PermissionSetProvisioned waits for a Permission Set provisioning request to return SUCCEEDED
+func PermissionSetProvisioned(conn *ssoadmin.SSOAdmin, instanceArn, requestID string, timeout time.Duration) (*ssoadmin.PermissionSetProvisioningStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    PermissionSetProvisioningStatus(conn, instanceArn, requestID),
+		Timeout:    timeout,
+		Delay:      PermissionSetProvisioningDelay,
+		MinTimeout: PermissionSetProvisioningMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.PermissionSetProvisioningStatus); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/listpages/main.go -function=ListIPSets,ListRateBasedRules,ListRules,ListWebACLs -paginator=NextMarker github.com/aws/aws-sdk-go/service/wafregional
+
+package lister
@@ -0,0 +1,95 @@
+// Code generated by "aws/internal/generators/listpages/main.go -function=ListIPSets,ListRateBasedRules,ListRules,ListWebACLs -paginator=NextMarker github.com/aws/aws-sdk-go/service/wafregional"; DO NOT EDIT.
+
+package lister
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+)
+
+func ListIPSetsPages(conn *wafregional.WAFRegional, input *waf.ListIPSetsInput, fn func(*waf.ListIPSetsOutput, bool) bool) error {
+	return ListIPSetsPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func ListIPSetsPagesWithContext(ctx context.Context, conn *wafregional.WAFRegional, input *waf.ListIPSetsInput, fn func(*waf.ListIPSetsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListIPSetsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextMarker = output.NextMarker
+	}
+	return nil
+}
+
+func ListRateBasedRulesPages(conn *wafregional.WAFRegional, input *waf.ListRateBasedRulesInput, fn func(*waf.ListRateBasedRulesOutput, bool) bool) error {
+	return ListRateBasedRulesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func ListRateBasedRulesPagesWithContext(ctx context.Context, conn *wafregional.WAFRegional, input *waf.ListRateBasedRulesInput, fn func(*waf.ListRateBasedRulesOutput, bool) bool) error {
+	for {
+		output, err := conn.ListRateBasedRulesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextMarker = output.NextMarker
+	}
+	return nil
+}
+
+func ListRulesPages(conn *wafregional.WAFRegional, input *waf.ListRulesInput, fn func(*waf.ListRulesOutput, bool) bool) error {
+	return ListRulesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func ListRulesPagesWithContext(ctx context.Context, conn *wafregional.WAFRegional, input *waf.ListRulesInput, fn func(*waf.ListRulesOutput, bool) bool) error {
+	for {
+		output, err := conn.ListRulesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextMarker = output.NextMarker
+	}
+	return nil
+}
+
+func ListWebACLsPages(conn *wafregional.WAFRegional, input *waf.ListWebACLsInput, fn func(*waf.ListWebACLsOutput, bool) bool) error {
+	return ListWebACLsPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func ListWebACLsPagesWithContext(ctx context.Context, conn *wafregional.WAFRegional, input *waf.ListWebACLsInput, fn func(*waf.ListWebACLsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListWebACLsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextMarker = output.NextMarker
+	}
+	return nil
+}
@@ -1,3 +1,3 @@
-//go:generate go run ../../../generators/listpages/main.go -function=ListIPSets,ListRegexPatternSets,ListRuleGroups,ListWebACLs -paginator=NextMarker github.com/aws/aws-sdk-go/service/wafv2
+//go:generate go run ../../../generators/listpages/main.go -function=ListIPSets:IPSets,ListRegexPatternSets:RegexPatternSets,ListRuleGroups:RuleGroups,ListWebACLs:WebACLs -paginator=NextMarker github.com/aws/aws-sdk-go/service/wafv2
 
 package lister
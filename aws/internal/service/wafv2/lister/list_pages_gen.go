@@ -1,4 +1,4 @@
-// Code generated by "aws/internal/generators/listpages/main.go -function=ListIPSets,ListRegexPatternSets,ListRuleGroups,ListWebACLs -paginator=NextMarker github.com/aws/aws-sdk-go/service/wafv2"; DO NOT EDIT.
+// Code generated by "aws/internal/generators/listpages/main.go -function=ListIPSets:IPSets,ListRegexPatternSets:RegexPatternSets,ListRuleGroups:RuleGroups,ListWebACLs:WebACLs -paginator=NextMarker github.com/aws/aws-sdk-go/service/wafv2"; DO NOT EDIT.
 
 package lister
 
@@ -20,7 +20,7 @@ func ListIPSetsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, input *w
 			return err
 		}
 
-		lastPage := aws.StringValue(output.NextMarker) == ""
+		lastPage := aws.StringValue(output.NextMarker) == "" || len(output.IPSets) == 0
 		if !fn(output, lastPage) || lastPage {
 			break
 		}
@@ -41,7 +41,7 @@ func ListRegexPatternSetsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2
 			return err
 		}
 
-		lastPage := aws.StringValue(output.NextMarker) == ""
+		lastPage := aws.StringValue(output.NextMarker) == "" || len(output.RegexPatternSets) == 0
 		if !fn(output, lastPage) || lastPage {
 			break
 		}
@@ -62,7 +62,7 @@ func ListRuleGroupsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, inpu
 			return err
 		}
 
-		lastPage := aws.StringValue(output.NextMarker) == ""
+		lastPage := aws.StringValue(output.NextMarker) == "" || len(output.RuleGroups) == 0
 		if !fn(output, lastPage) || lastPage {
 			break
 		}
@@ -83,7 +83,7 @@ func ListWebACLsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, input *
 			return err
 		}
 
-		lastPage := aws.StringValue(output.NextMarker) == ""
+		lastPage := aws.StringValue(output.NextMarker) == "" || len(output.WebACLs) == 0
 		if !fn(output, lastPage) || lastPage {
 			break
 		}
@@ -0,0 +1,136 @@
+package sync
+
+import (
+	"strings"
+	gosync "sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	handlerNameAcquire         = "terraform-provider-aws.sync.AcquireServiceSemaphore"
+	handlerNameReleaseAttempt  = "terraform-provider-aws.sync.ReleaseServiceSemaphoreAttempt"
+	handlerNameReleaseComplete = "terraform-provider-aws.sync.ReleaseServiceSemaphoreComplete"
+)
+
+// ServiceSemaphores limits the number of concurrent API requests per service.
+// Semaphores are keyed by the AWS SDK service name (for example "organizations" or "events")
+// or the AWS SDK package name (for example "cloudwatchevents" or "servicequotas").
+type ServiceSemaphores map[string]Semaphore
+
+// NewServiceSemaphores returns semaphores for the given per-service concurrency limits.
+// Services with a limit less than one are not limited.
+func NewServiceSemaphores(limits map[string]int) ServiceSemaphores {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	semaphores := make(ServiceSemaphores, len(limits))
+
+	for service, limit := range limits {
+		if limit < 1 {
+			continue
+		}
+
+		semaphores[service] = NewSemaphore(limit)
+	}
+
+	return semaphores
+}
+
+// ConfigureSession installs request handlers enforcing the concurrency limits on the
+// session. Service clients subsequently created from copies of the session share the
+// semaphores, so the limits apply across all resources using the same provider.
+//
+// A semaphore is held for each attempt of a request, from signing until the response
+// has been handled, and is not held while waiting to retry.
+func (s ServiceSemaphores) ConfigureSession(sess *session.Session) {
+	if len(s) == 0 || sess == nil {
+		return
+	}
+
+	h := &semaphoreHandlers{
+		semaphores: s,
+		held:       make(map[*request.Request]Semaphore),
+	}
+
+	sess.Handlers.Sign.PushBackNamed(request.NamedHandler{
+		Name: handlerNameAcquire,
+		Fn:   h.acquire,
+	})
+
+	sess.Handlers.CompleteAttempt.PushFrontNamed(request.NamedHandler{
+		Name: handlerNameReleaseAttempt,
+		Fn:   h.release,
+	})
+
+	// Releasing on completion covers attempts that are never sent,
+	// for example when a signing handler after this one fails.
+	sess.Handlers.Complete.PushFrontNamed(request.NamedHandler{
+		Name: handlerNameReleaseComplete,
+		Fn:   h.release,
+	})
+}
+
+// lookup returns the semaphore for a service.
+func (s ServiceSemaphores) lookup(info metadata.ClientInfo) (Semaphore, bool) {
+	if semaphore, ok := s[info.ServiceName]; ok {
+		return semaphore, true
+	}
+
+	semaphore, ok := s[strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(info.ServiceID))]
+
+	return semaphore, ok
+}
+
+// semaphoreHandlers tracks which requests hold a service semaphore.
+type semaphoreHandlers struct {
+	semaphores ServiceSemaphores
+
+	mu   gosync.Mutex
+	held map[*request.Request]Semaphore
+}
+
+func (h *semaphoreHandlers) acquire(r *request.Request) {
+	// Presigned requests are not sent.
+	if r.Error != nil || r.IsPresigned() {
+		return
+	}
+
+	semaphore, ok := h.semaphores.lookup(r.ClientInfo)
+
+	if !ok {
+		return
+	}
+
+	h.mu.Lock()
+	_, held := h.held[r]
+	h.mu.Unlock()
+
+	if held {
+		return
+	}
+
+	if err := semaphore.WaitContext(r.Context()); err != nil {
+		r.Error = awserr.New(request.CanceledErrorCode, "request context canceled waiting for concurrency limit", err)
+		return
+	}
+
+	h.mu.Lock()
+	h.held[r] = semaphore
+	h.mu.Unlock()
+}
+
+func (h *semaphoreHandlers) release(r *request.Request) {
+	h.mu.Lock()
+	semaphore, ok := h.held[r]
+	delete(h.held, r)
+	h.mu.Unlock()
+
+	if ok {
+		semaphore.Notify()
+	}
+}
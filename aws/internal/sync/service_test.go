@@ -0,0 +1,130 @@
+package sync
+
+import (
+	"net/http"
+	"net/http/httptest"
+	gosync "sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestNewServiceSemaphores(t *testing.T) {
+	semaphores := NewServiceSemaphores(map[string]int{
+		"organizations": 2,
+		"route53":       0,
+	})
+
+	if got, expected := len(semaphores), 1; got != expected {
+		t.Fatalf("got %d semaphores, expected %d", got, expected)
+	}
+
+	if got, expected := cap(semaphores["organizations"]), 2; got != expected {
+		t.Errorf("got capacity %d, expected %d", got, expected)
+	}
+
+	if semaphores := NewServiceSemaphores(nil); semaphores != nil {
+		t.Errorf("got %v, expected nil", semaphores)
+	}
+}
+
+func TestServiceSemaphoresConfigureSession(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"), // lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	semaphores := NewServiceSemaphores(map[string]int{
+		"servicequotas": 2,
+	})
+	semaphores.ConfigureSession(sess)
+
+	testCases := []struct {
+		Name        string
+		ServiceName string
+		ServiceID   string
+		Limited     bool
+	}{
+		{
+			Name:        "limited service",
+			ServiceName: "Service Quotas",
+			ServiceID:   "Service Quotas",
+			Limited:     true,
+		},
+		{
+			Name:        "unlimited service",
+			ServiceName: "ec2",
+			ServiceID:   "EC2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			atomic.StoreInt32(&maxInFlight, 0)
+
+			c := client.New(*sess.Config, metadata.ClientInfo{ServiceName: testCase.ServiceName, ServiceID: testCase.ServiceID, Endpoint: server.URL}, sess.Handlers)
+
+			var wg gosync.WaitGroup
+
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					r := c.NewRequest(&request.Operation{Name: "Test", HTTPMethod: "GET", HTTPPath: "/"}, nil, nil)
+
+					if err := r.Send(); err != nil {
+						t.Errorf("error sending request: %s", err)
+					}
+				}()
+			}
+
+			wg.Wait()
+
+			got := atomic.LoadInt32(&maxInFlight)
+
+			if testCase.Limited && got > 2 {
+				t.Errorf("got %d concurrent requests, expected at most 2", got)
+			}
+
+			if !testCase.Limited && got <= 2 {
+				t.Errorf("got %d concurrent requests, expected more than 2", got)
+			}
+
+			if got := len(semaphores["servicequotas"]); got != 0 {
+				t.Errorf("got %d semaphores held after completion, expected none", got)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -11,8 +12,12 @@ import (
 // Semaphore can be used to limit concurrent executions. This can be used to work with resources with low quotas
 type Semaphore chan struct{}
 
+// NewSemaphore returns a semaphore allowing up to limit concurrent executions.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
 // InitializeSemaphore initializes a semaphore with a default capacity or overrides it using an environment variable
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func InitializeSemaphore(envvar string, defaultLimit int) Semaphore {
 	limit := defaultLimit
 	x := os.Getenv(envvar)
@@ -23,17 +28,25 @@ func InitializeSemaphore(envvar string, defaultLimit int) Semaphore {
 			panic(fmt.Errorf("could not parse %q: expected integer, got %q", envvar, x))
 		}
 	}
-	return make(Semaphore, limit)
+	return NewSemaphore(limit)
 }
 
 // Wait waits for a semaphore before continuing
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
+// WaitContext waits for a semaphore before continuing or returns an error if the context is done first
+func (s Semaphore) WaitContext(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Notify() {
 	// Make the Notify non-blocking. This can happen if a Wait was never issued
 	select {
@@ -44,7 +57,6 @@ func (s Semaphore) Notify() {
 }
 
 // TestAccPreCheckSyncronized waits for a semaphore and skips the test if there is no capacity
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func TestAccPreCheckSyncronize(t *testing.T, semaphore Semaphore, resource string) {
 	if cap(semaphore) == 0 {
 		t.Skipf("concurrency for %s testing set to 0", resource)
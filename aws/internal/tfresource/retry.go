@@ -0,0 +1,70 @@
+package tfresource
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Retryable is a function that is used to decide if a function's error is retryable or not.
+// The error argument can be `nil`.
+// If the error is retryable, returns a bool value of `true` and an error (not necessarily the error passed as the argument).
+// If the error is not retryable, returns a bool value of `false` and either no error (success state) or an error (not necessarily the error passed as the argument).
+type Retryable func(error) (bool, error)
+
+// RetryWhen retries the function `f` when the error it returns is retryable.
+// `f` is retried until `timeout` expires.
+func RetryWhen(timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	var output interface{}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		var retry bool
+
+		output, err = f()
+		retry, err = retryable(err)
+
+		if retry {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if TimedOut(err) {
+		output, err = f()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// RetryWhenNotFound retries the specified function when it returns a resource.NotFoundError.
+func RetryWhenNotFound(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhen(timeout, f, func(err error) (bool, error) {
+		if NotFound(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenNewResourceNotFound retries the specified function when it returns a resource.NotFoundError and `isNewResource` is true.
+// This covers the eventual consistency of reading a resource immediately after it has been created.
+func RetryWhenNewResourceNotFound(timeout time.Duration, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
+	return RetryWhen(timeout, f, func(err error) (bool, error) {
+		if isNewResource && NotFound(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
@@ -0,0 +1,97 @@
+package tfresource
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRetryWhenNewResourceNotFound(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		F             func(*int) func() (interface{}, error)
+		IsNewResource bool
+		ExpectedCount int
+		ExpectError   bool
+		ExpectedOut   interface{}
+	}{
+		{
+			Name: "no error",
+			F: func(count *int) func() (interface{}, error) {
+				return func() (interface{}, error) {
+					*count++
+
+					return "ok", nil
+				}
+			},
+			ExpectedCount: 1,
+			ExpectedOut:   "ok",
+		},
+		{
+			Name: "non-retryable other error",
+			F: func(count *int) func() (interface{}, error) {
+				return func() (interface{}, error) {
+					*count++
+
+					return nil, errors.New("TestCode")
+				}
+			},
+			IsNewResource: true,
+			ExpectedCount: 1,
+			ExpectError:   true,
+		},
+		{
+			Name: "not found error existing resource",
+			F: func(count *int) func() (interface{}, error) {
+				return func() (interface{}, error) {
+					*count++
+
+					return nil, &resource.NotFoundError{}
+				}
+			},
+			ExpectedCount: 1,
+			ExpectError:   true,
+		},
+		{
+			Name: "not found error new resource",
+			F: func(count *int) func() (interface{}, error) {
+				return func() (interface{}, error) {
+					*count++
+
+					if *count < 3 {
+						return nil, &resource.NotFoundError{}
+					}
+
+					return "ok", nil
+				}
+			},
+			IsNewResource: true,
+			ExpectedCount: 3,
+			ExpectedOut:   "ok",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var count int
+
+			out, err := RetryWhenNewResourceNotFound(5*time.Second, testCase.F(&count), testCase.IsNewResource)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if count != testCase.ExpectedCount {
+				t.Errorf("got %d calls, expected %d", count, testCase.ExpectedCount)
+			}
+
+			if out != testCase.ExpectedOut {
+				t.Errorf("got %v, expected %v", out, testCase.ExpectedOut)
+			}
+		})
+	}
+}
@@ -213,7 +213,8 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
-		"tags": tagsSchema(),
+		"tags":     tagsSchema(),
+		"tags_all": tagsSchemaComputed(),
 	}
 
 	if lt.CustomShortName {
@@ -248,9 +249,7 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 	return &schema.Resource{
 		Read: func(d *schema.ResourceData, meta interface{}) error {
 			client := meta.(*AWSClient).opsworksconn
-			ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
-
-			return lt.Read(d, client, ignoreTagsConfig)
+			return lt.Read(d, client, meta)
 		},
 		Create: func(d *schema.ResourceData, meta interface{}) error {
 			client := meta.(*AWSClient).opsworksconn
@@ -258,9 +257,7 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 		},
 		Update: func(d *schema.ResourceData, meta interface{}) error {
 			client := meta.(*AWSClient).opsworksconn
-			ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
-
-			return lt.Update(d, client, ignoreTagsConfig)
+			return lt.Update(d, client, meta)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
 			client := meta.(*AWSClient).opsworksconn
@@ -270,11 +267,15 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: resourceSchema,
 	}
 }
 
-func (lt *opsworksLayerType) Read(d *schema.ResourceData, client *opsworks.OpsWorks, ignoreTagsConfig *keyvaluetags.IgnoreConfig) error {
+func (lt *opsworksLayerType) Read(d *schema.ResourceData, client *opsworks.OpsWorks, meta interface{}) error {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &opsworks.DescribeLayersInput{
 		LayerIds: []*string{
@@ -356,15 +357,23 @@ func (lt *opsworksLayerType) Read(d *schema.ResourceData, client *opsworks.OpsWo
 		return fmt.Errorf("error listing tags for Opsworks Layer (%s): %s", arn, err)
 	}
 
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
 func (lt *opsworksLayerType) Create(d *schema.ResourceData, client *opsworks.OpsWorks, meta interface{}) error {
-	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	attributes, err := lt.AttributeMap(d)
 	if err != nil {
@@ -426,16 +435,16 @@ func (lt *opsworksLayerType) Create(d *schema.ResourceData, client *opsworks.Ops
 		Resource:  fmt.Sprintf("layer/%s", d.Id()),
 	}.String()
 
-	if v, ok := d.GetOk("tags"); ok {
-		if err := keyvaluetags.OpsworksUpdateTags(client, arn, nil, v.(map[string]interface{})); err != nil {
+	if len(tags) > 0 {
+		if err := keyvaluetags.OpsworksUpdateTags(client, arn, nil, tags); err != nil {
 			return fmt.Errorf("error updating Opsworks stack (%s) tags: %s", arn, err)
 		}
 	}
 
-	return lt.Read(d, client, ignoreTagsConfig)
+	return lt.Read(d, client, meta)
 }
 
-func (lt *opsworksLayerType) Update(d *schema.ResourceData, client *opsworks.OpsWorks, ignoreTagsConfig *keyvaluetags.IgnoreConfig) error {
+func (lt *opsworksLayerType) Update(d *schema.ResourceData, client *opsworks.OpsWorks, meta interface{}) error {
 	attributes, err := lt.AttributeMap(d)
 	if err != nil {
 		return err
@@ -501,8 +510,8 @@ func (lt *opsworksLayerType) Update(d *schema.ResourceData, client *opsworks.Ops
 		return err
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		arn := d.Get("arn").(string)
 		if err := keyvaluetags.OpsworksUpdateTags(client, arn, o, n); err != nil {
@@ -510,7 +519,7 @@ func (lt *opsworksLayerType) Update(d *schema.ResourceData, client *opsworks.Ops
 		}
 	}
 
-	return lt.Read(d, client, ignoreTagsConfig)
+	return lt.Read(d, client, meta)
 }
 
 func (lt *opsworksLayerType) Delete(d *schema.ResourceData, client *opsworks.OpsWorks) error {
@@ -1,13 +1,28 @@
 package aws
 
 import (
+	"context"
 	"log"
-
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/assumerole"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/auditlog"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mutexkv"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retry"
 )
 
+// auditLogCallerPackage is the prefix of the names of functions in this package.
+var auditLogCallerPackage = reflect.TypeOf(AWSClient{}).PkgPath() + "."
+
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
 	// TODO: Move the validation to this, requires conditional schemas
@@ -39,6 +54,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -71,6 +88,32 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry": retrySchema(),
+
+			"audit_log": auditLogSchema(),
+
+			"max_concurrent_requests": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration block(s) limiting the number of concurrent API requests to a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"limit": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of concurrent API requests to the service.",
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "AWS SDK service or package name, for example `organizations` or `servicequotas`.",
+						},
+					},
+				},
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -89,6 +132,23 @@ func Provider() *schema.Provider {
 
 			"endpoints": endpointsSchema(),
 
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to default resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags to default across all resources.",
+						},
+					},
+				},
+			},
+
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -110,6 +170,48 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"resource_type": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Configuration blocks with settings to ignore resource tags for specific resource types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Terraform resource type, e.g. aws_instance.",
+									},
+									"keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Resource tag keys to ignore for the resource type.",
+									},
+									"key_prefixes": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Resource tag key prefixes to ignore for the resource type.",
+									},
+									"key_regexes": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+										Set:         schema.HashString,
+										Description: "Regular expressions matching resource tag keys to ignore for the resource type.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -188,7 +290,10 @@ func Provider() *schema.Provider {
 			"aws_canonical_user_id":                          dataSourceAwsCanonicalUserId(),
 			"aws_cloudformation_export":                      dataSourceAwsCloudFormationExport(),
 			"aws_cloudformation_stack":                       dataSourceAwsCloudFormationStack(),
+			"aws_cloudfront_cache_policy":                    dataSourceAwsCloudFrontCachePolicy(),
 			"aws_cloudfront_distribution":                    dataSourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_request_policy":           dataSourceAwsCloudFrontOriginRequestPolicy(),
+			"aws_cloudfront_realtime_log_config":             dataSourceAwsCloudFrontRealtimeLogConfig(),
 			"aws_cloudhsm_v2_cluster":                        dataSourceCloudHsmV2Cluster(),
 			"aws_cloudtrail_service_account":                 dataSourceAwsCloudTrailServiceAccount(),
 			"aws_cloudwatch_log_group":                       dataSourceAwsCloudwatchLogGroup(),
@@ -246,6 +351,7 @@ func Provider() *schema.Provider {
 			"aws_efs_file_system":                            dataSourceAwsEfsFileSystem(),
 			"aws_efs_mount_target":                           dataSourceAwsEfsMountTarget(),
 			"aws_eip":                                        dataSourceAwsEip(),
+			"aws_eks_addon":                                  dataSourceAwsEksAddon(),
 			"aws_eks_cluster":                                dataSourceAwsEksCluster(),
 			"aws_eks_cluster_auth":                           dataSourceAwsEksClusterAuth(),
 			"aws_elastic_beanstalk_application":              dataSourceAwsElasticBeanstalkApplication(),
@@ -285,6 +391,7 @@ func Provider() *schema.Provider {
 			"aws_kms_secret":                                 dataSourceAwsKmsSecret(),
 			"aws_kms_secrets":                                dataSourceAwsKmsSecrets(),
 			"aws_lakeformation_data_lake_settings":           dataSourceAwsLakeFormationDataLakeSettings(),
+			"aws_lakeformation_permissions":                  dataSourceAwsLakeFormationPermissions(),
 			"aws_lambda_alias":                               dataSourceAwsLambdaAlias(),
 			"aws_lambda_code_signing_config":                 dataSourceAwsLambdaCodeSigningConfig(),
 			"aws_lambda_function":                            dataSourceAwsLambdaFunction(),
@@ -327,6 +434,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_service_account":                   dataSourceAwsRedshiftServiceAccount(),
 			"aws_region":                                     dataSourceAwsRegion(),
 			"aws_regions":                                    dataSourceAwsRegions(),
+			"aws_resourcegroupstaggingapi_resources":         dataSourceAwsResourceGroupsTaggingAPIResources(),
 			"aws_route":                                      dataSourceAwsRoute(),
 			"aws_route_table":                                dataSourceAwsRouteTable(),
 			"aws_route_tables":                               dataSourceAwsRouteTables(),
@@ -353,6 +461,7 @@ func Provider() *schema.Provider {
 			"aws_ssm_document":                               dataSourceAwsSsmDocument(),
 			"aws_ssm_parameter":                              dataSourceAwsSsmParameter(),
 			"aws_ssm_patch_baseline":                         dataSourceAwsSsmPatchBaseline(),
+			"aws_ssoadmin_instances":                         dataSourceAwsSsoAdminInstances(),
 			"aws_storagegateway_local_disk":                  dataSourceAwsStorageGatewayLocalDisk(),
 			"aws_subnet":                                     dataSourceAwsSubnet(),
 			"aws_subnet_ids":                                 dataSourceAwsSubnetIDs(),
@@ -475,10 +584,15 @@ func Provider() *schema.Provider {
 			"aws_cloudformation_stack":                                resourceAwsCloudFormationStack(),
 			"aws_cloudformation_stack_set":                            resourceAwsCloudFormationStackSet(),
 			"aws_cloudformation_stack_set_instance":                   resourceAwsCloudFormationStackSetInstance(),
+			"aws_cloudfront_cache_policy":                             resourceAwsCloudFrontCachePolicy(),
 			"aws_cloudfront_distribution":                             resourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_key_group":                                resourceAwsCloudFrontKeyGroup(),
 			"aws_cloudfront_origin_access_identity":                   resourceAwsCloudFrontOriginAccessIdentity(),
+			"aws_cloudfront_origin_request_policy":                    resourceAwsCloudFrontOriginRequestPolicy(),
 			"aws_cloudfront_public_key":                               resourceAwsCloudFrontPublicKey(),
+			"aws_cloudfront_realtime_log_config":                      resourceAwsCloudFrontRealtimeLogConfig(),
 			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_archive":                            resourceAwsCloudWatchEventArchive(),
 			"aws_cloudwatch_event_bus":                                resourceAwsCloudWatchEventBus(),
 			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
 			"aws_cloudwatch_event_rule":                               resourceAwsCloudWatchEventRule(),
@@ -625,6 +739,7 @@ func Provider() *schema.Provider {
 			"aws_ecs_cluster":                                         resourceAwsEcsCluster(),
 			"aws_ecs_service":                                         resourceAwsEcsService(),
 			"aws_ecs_task_definition":                                 resourceAwsEcsTaskDefinition(),
+			"aws_ecs_task_set":                                        resourceAwsEcsTaskSet(),
 			"aws_efs_access_point":                                    resourceAwsEfsAccessPoint(),
 			"aws_efs_file_system":                                     resourceAwsEfsFileSystem(),
 			"aws_efs_file_system_policy":                              resourceAwsEfsFileSystemPolicy(),
@@ -632,14 +747,18 @@ func Provider() *schema.Provider {
 			"aws_egress_only_internet_gateway":                        resourceAwsEgressOnlyInternetGateway(),
 			"aws_eip":                                                 resourceAwsEip(),
 			"aws_eip_association":                                     resourceAwsEipAssociation(),
+			"aws_eks_addon":                                           resourceAwsEksAddon(),
 			"aws_eks_cluster":                                         resourceAwsEksCluster(),
 			"aws_eks_fargate_profile":                                 resourceAwsEksFargateProfile(),
 			"aws_eks_node_group":                                      resourceAwsEksNodeGroup(),
 			"aws_elasticache_cluster":                                 resourceAwsElasticacheCluster(),
+			"aws_elasticache_global_replication_group":                resourceAwsElasticacheGlobalReplicationGroup(),
 			"aws_elasticache_parameter_group":                         resourceAwsElasticacheParameterGroup(),
 			"aws_elasticache_replication_group":                       resourceAwsElasticacheReplicationGroup(),
 			"aws_elasticache_security_group":                          resourceAwsElasticacheSecurityGroup(),
 			"aws_elasticache_subnet_group":                            resourceAwsElasticacheSubnetGroup(),
+			"aws_elasticache_user":                                    resourceAwsElasticacheUser(),
+			"aws_elasticache_user_group":                              resourceAwsElasticacheUserGroup(),
 			"aws_elastic_beanstalk_application":                       resourceAwsElasticBeanstalkApplication(),
 			"aws_elastic_beanstalk_application_version":               resourceAwsElasticBeanstalkApplicationVersion(),
 			"aws_elastic_beanstalk_configuration_template":            resourceAwsElasticBeanstalkConfigurationTemplate(),
@@ -659,6 +778,7 @@ func Provider() *schema.Provider {
 			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
 			"aws_fsx_windows_file_system":                             resourceAwsFsxWindowsFileSystem(),
 			"aws_fms_admin_account":                                   resourceAwsFmsAdminAccount(),
+			"aws_fms_policy":                                          resourceAwsFmsPolicy(),
 			"aws_gamelift_alias":                                      resourceAwsGameliftAlias(),
 			"aws_gamelift_build":                                      resourceAwsGameliftBuild(),
 			"aws_gamelift_fleet":                                      resourceAwsGameliftFleet(),
@@ -747,6 +867,7 @@ func Provider() *schema.Provider {
 			"aws_kms_key":                                             resourceAwsKmsKey(),
 			"aws_kms_ciphertext":                                      resourceAwsKmsCiphertext(),
 			"aws_lakeformation_data_lake_settings":                    resourceAwsLakeFormationDataLakeSettings(),
+			"aws_lakeformation_permissions":                           resourceAwsLakeFormationPermissions(),
 			"aws_lakeformation_resource":                              resourceAwsLakeFormationResource(),
 			"aws_lambda_alias":                                        resourceAwsLambdaAlias(),
 			"aws_lambda_code_signing_config":                          resourceAwsLambdaCodeSigningConfig(),
@@ -847,14 +968,18 @@ func Provider() *schema.Provider {
 			"aws_redshift_snapshot_schedule":                          resourceAwsRedshiftSnapshotSchedule(),
 			"aws_redshift_snapshot_schedule_association":              resourceAwsRedshiftSnapshotScheduleAssociation(),
 			"aws_redshift_event_subscription":                         resourceAwsRedshiftEventSubscription(),
+			"aws_resource_tags":                                       resourceAwsResourceTags(),
 			"aws_resourcegroups_group":                                resourceAwsResourceGroupsGroup(),
 			"aws_route53_delegation_set":                              resourceAwsRoute53DelegationSet(),
+			"aws_route53_hosted_zone_dnssec":                          resourceAwsRoute53HostedZoneDnssec(),
+			"aws_route53_key_signing_key":                             resourceAwsRoute53KeySigningKey(),
 			"aws_route53_query_log":                                   resourceAwsRoute53QueryLog(),
 			"aws_route53_record":                                      resourceAwsRoute53Record(),
 			"aws_route53_zone_association":                            resourceAwsRoute53ZoneAssociation(),
 			"aws_route53_vpc_association_authorization":               resourceAwsRoute53VPCAssociationAuthorization(),
 			"aws_route53_zone":                                        resourceAwsRoute53Zone(),
 			"aws_route53_health_check":                                resourceAwsRoute53HealthCheck(),
+			"aws_route53_resolver_dnssec_config":                      resourceAwsRoute53ResolverDnssecConfig(),
 			"aws_route53_resolver_endpoint":                           resourceAwsRoute53ResolverEndpoint(),
 			"aws_route53_resolver_query_log_config":                   resourceAwsRoute53ResolverQueryLogConfig(),
 			"aws_route53_resolver_query_log_config_association":       resourceAwsRoute53ResolverQueryLogConfigAssociation(),
@@ -891,7 +1016,11 @@ func Provider() *schema.Provider {
 			"aws_s3_access_point":                                     resourceAwsS3AccessPoint(),
 			"aws_s3_account_public_access_block":                      resourceAwsS3AccountPublicAccessBlock(),
 			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
+			"aws_s3_bucket_acl":                                       resourceAwsS3BucketAcl(),
 			"aws_s3_bucket_analytics_configuration":                   resourceAwsS3BucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
 			"aws_s3_bucket_policy":                                    resourceAwsS3BucketPolicy(),
 			"aws_s3_bucket_public_access_block":                       resourceAwsS3BucketPublicAccessBlock(),
 			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
@@ -899,6 +1028,11 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_s3_bucket_intelligent_tiering_configuration":         resourceAwsS3BucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_replication_configuration":                 resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
 			"aws_s3control_bucket":                                    resourceAwsS3ControlBucket(),
 			"aws_s3control_bucket_policy":                             resourceAwsS3ControlBucketPolicy(),
 			"aws_s3control_bucket_lifecycle_configuration":            resourceAwsS3ControlBucketLifecycleConfiguration(),
@@ -933,6 +1067,10 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
 			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
 			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
+			"aws_ssoadmin_account_assignment":                         resourceAwsSsoAdminAccountAssignment(),
+			"aws_ssoadmin_managed_policy_attachment":                  resourceAwsSsoAdminManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                             resourceAwsSsoAdminPermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":               resourceAwsSsoAdminPermissionSetInlinePolicy(),
 			"aws_storagegateway_cache":                                resourceAwsStorageGatewayCache(),
 			"aws_storagegateway_cached_iscsi_volume":                  resourceAwsStorageGatewayCachedIscsiVolume(),
 			"aws_storagegateway_gateway":                              resourceAwsStorageGatewayGateway(),
@@ -1060,6 +1198,28 @@ func Provider() *schema.Provider {
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = dataSourceAwsServerlessApplicationRepositoryApplication()
 	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = resourceAwsServerlessApplicationRepositoryCloudFormationStack()
 
+	// Audit log entries are attributed to resource types by the functions on the
+	// call stack, so these must be registered before they are wrapped below.
+	auditLogCallers := auditlog.NewCallers()
+
+	for resourceType, resource := range provider.ResourcesMap {
+		registerAuditLogCallers(auditLogCallers, resourceType, resource)
+	}
+
+	for resourceType, dataSource := range provider.DataSourcesMap {
+		registerAuditLogCallers(auditLogCallers, resourceType, dataSource)
+	}
+
+	// Resource type specific ignore_tags configuration is applied by scoping
+	// the provider meta passed to each resource and data source.
+	for resourceType, resource := range provider.ResourcesMap {
+		scopeResourceProviderMeta(resourceType, resource)
+	}
+
+	for resourceType, dataSource := range provider.DataSourcesMap {
+		scopeResourceProviderMeta(resourceType, dataSource)
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1067,12 +1227,127 @@ func Provider() *schema.Provider {
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(d, terraformVersion)
+		return providerConfigure(d, terraformVersion, auditLogCallers)
 	}
 
 	return provider
 }
 
+// registerAuditLogCallers registers the functions of a resource or data source implemented
+// by this provider with the audit log. Functions from other packages, such as
+// schema.ImportStatePassthrough, are shared by many resource types and are not registered.
+func registerAuditLogCallers(callers *auditlog.Callers, resourceType string, r *schema.Resource) {
+	fns := []interface{}{
+		r.Create,
+		r.Read,
+		r.Update,
+		r.Delete,
+		r.Exists,
+		r.CreateContext,
+		r.ReadContext,
+		r.UpdateContext,
+		r.DeleteContext,
+	}
+
+	if r.Importer != nil {
+		fns = append(fns, r.Importer.State, r.Importer.StateContext)
+	}
+
+	for _, fn := range fns {
+		v := reflect.ValueOf(fn)
+
+		if v.Kind() != reflect.Func || v.IsNil() {
+			continue
+		}
+
+		if f := runtime.FuncForPC(v.Pointer()); f == nil || !strings.HasPrefix(f.Name(), auditLogCallerPackage) {
+			continue
+		}
+
+		callers.Register(resourceType, fn)
+	}
+}
+
+// scopeResourceProviderMeta wraps the functions of a resource or data source so that
+// they receive a provider meta scoped to the resource type. See AWSClient.ForResourceType.
+func scopeResourceProviderMeta(resourceType string, r *schema.Resource) {
+	scope := func(meta interface{}) interface{} {
+		if client, ok := meta.(*AWSClient); ok && client != nil {
+			return client.ForResourceType(resourceType)
+		}
+
+		return meta
+	}
+
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error { return f(d, scope(meta)) }
+	}
+
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error { return f(d, scope(meta)) }
+	}
+
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error { return f(d, scope(meta)) }
+	}
+
+	if f := r.Delete; f != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error { return f(d, scope(meta)) }
+	}
+
+	if f := r.Exists; f != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) { return f(d, scope(meta)) }
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, scope(meta))
+		}
+	}
+
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, scope(meta))
+		}
+	}
+
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, scope(meta))
+		}
+	}
+
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, scope(meta))
+		}
+	}
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return f(ctx, diff, scope(meta))
+		}
+	}
+
+	if r.Importer != nil {
+		importer := *r.Importer
+
+		if f := importer.State; f != nil {
+			importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return f(d, scope(meta))
+			}
+		}
+
+		if f := importer.StateContext; f != nil {
+			importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return f(ctx, d, scope(meta))
+			}
+		}
+
+		r.Importer = &importer
+	}
+}
+
 var descriptions map[string]string
 var endpointServiceNames []string
 
@@ -1280,7 +1555,7 @@ func init() {
 	}
 }
 
-func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, terraformVersion string, auditLogCallers *auditlog.Callers) (interface{}, error) {
 	config := Config{
 		AccessKey:               d.Get("access_key").(string),
 		SecretKey:               d.Get("secret_key").(string),
@@ -1290,6 +1565,9 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		CredsFilename:           d.Get("shared_credentials_file").(string),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
+		RetryConfig:             expandProviderRetry(d.Get("retry").([]interface{}), d.Get("max_retries").(int)),
+		ConcurrencyLimits:       expandProviderMaxConcurrentRequests(d.Get("max_concurrent_requests").(*schema.Set).List()),
+		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
@@ -1301,68 +1579,16 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		terraformVersion:        terraformVersion,
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
-
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
-
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
-
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
-		}
-
-		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
-			for _, policyARNRaw := range policyARNSet.List() {
-				policyARN, ok := policyARNRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
-			}
-		}
-
-		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
-		}
-
-		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
-		}
-
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
-
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTags[k] = v
-			}
-		}
-
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
-
-				if !ok {
-					continue
-				}
+	config.AuditLogConfig = expandProviderAuditLog(d.Get("audit_log").([]interface{}), auditLogCallers)
+	config.AssumeRoles = expandProviderAssumeRoles(d.Get("assume_role").([]interface{}))
+	config.AssumeRoleWithWebIdentity = expandProviderAssumeRoleWithWebIdentity(d.Get("assume_role_with_web_identity").([]interface{}))
 
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
-			}
-		}
+	for i, role := range config.AssumeRoles {
+		log.Printf("[INFO] assume_role configuration set: (Hop: %d, ARN: %q, SessionID: %q, ExternalID: %q)", i+1, role.ARN, role.SessionName, role.ExternalID)
+	}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+	if webIdentity := config.AssumeRoleWithWebIdentity; webIdentity != nil {
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, TokenFile: %q)", webIdentity.ARN, webIdentity.SessionName, webIdentity.TokenFile)
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1394,9 +1620,9 @@ var awsMutexKV = mutexkv.NewMutexKV()
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Ordered list of IAM Roles to assume. Each role is assumed using the credentials of the previous one.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -1447,6 +1673,137 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  "Seconds to restrict the assume role session duration.",
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+					Description:  "Amazon Resource Name of an IAM Role to assume using the web identity token prior to making API calls.",
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token_file": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Path to a file containing an OAuth 2.0 access token or OpenID Connect ID token.",
+				},
+			},
+		},
+	}
+}
+
+func retryPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"burst": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Maximum number of requests sent at once before the client-side rate limit applies.",
+		},
+		"max_backoff_seconds": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Maximum number of seconds to wait between retries of a request.",
+		},
+		"mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(retry.Modes(), false),
+			Description:  "Retry mode. Valid values are `standard` and `adaptive`.",
+		},
+		"rate_limit": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+			Description:  "Maximum number of requests per second sent by the client-side token bucket rate limiter.",
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	policySchema := retryPolicySchema()
+
+	serviceSchema := retryPolicySchema()
+	serviceSchema["max_retries"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Maximum number of times a request to the service is retried.",
+	}
+	serviceSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "AWS SDK service or package name, for example `ec2` or `servicequotas`.",
+	}
+
+	policySchema["service"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Per-service overrides of the retry policy.",
+		Elem: &schema.Resource{
+			Schema: serviceSchema,
+		},
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to retry and rate limit API requests across all services.",
+		Elem: &schema.Resource{
+			Schema: policySchema,
+		},
+	}
+}
+
+func auditLogSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to write an audit log of AWS API calls.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"include_parameters": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Whether to include the API call input parameters, with sensitive values redacted, in each entry.",
+				},
+				"path": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "Path of the file that audit log entries are appended to, one JSON object per line.",
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1468,13 +1825,239 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandProviderAssumeRoles(l []interface{}) []*assumerole.Role {
+	var roles []*assumerole.Role
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		role := &assumerole.Role{}
+
+		if v, ok := tfMap["duration_seconds"].(int); ok && v != 0 {
+			role.DurationSeconds = v
+		}
+
+		if v, ok := tfMap["external_id"].(string); ok && v != "" {
+			role.ExternalID = v
+		}
+
+		if v, ok := tfMap["policy"].(string); ok && v != "" {
+			role.Policy = v
+		}
+
+		if v, ok := tfMap["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+			role.PolicyARNs = aws.StringValueSlice(expandStringSet(v))
+		}
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			role.ARN = v
+		}
+
+		if v, ok := tfMap["session_name"].(string); ok && v != "" {
+			role.SessionName = v
+		}
+
+		if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			role.Tags = aws.StringValueMap(stringMapToPointers(v))
+		}
+
+		if v, ok := tfMap["transitive_tag_keys"].(*schema.Set); ok && v.Len() > 0 {
+			role.TransitiveTagKeys = aws.StringValueSlice(expandStringSet(v))
+		}
+
+		// A block without a role ARN has never assumed a role.
+		if role.ARN == "" {
+			continue
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles
+}
+
+func expandProviderAssumeRoleWithWebIdentity(l []interface{}) *assumerole.WebIdentity {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	webIdentity := &assumerole.WebIdentity{}
+
+	if v, ok := tfMap["duration_seconds"].(int); ok && v != 0 {
+		webIdentity.DurationSeconds = v
+	}
+
+	if v, ok := tfMap["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+		webIdentity.PolicyARNs = aws.StringValueSlice(expandStringSet(v))
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		webIdentity.ARN = v
+	}
+
+	if v, ok := tfMap["session_name"].(string); ok && v != "" {
+		webIdentity.SessionName = v
+	}
+
+	if v, ok := tfMap["web_identity_token_file"].(string); ok && v != "" {
+		webIdentity.TokenFile = v
+	}
+
+	return webIdentity
+}
+
+func expandProviderAuditLog(l []interface{}, callers *auditlog.Callers) *auditlog.Config {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &auditlog.Config{
+		Callers:           callers,
+		IncludeParameters: m["include_parameters"].(bool),
+		Path:              m["path"].(string),
+	}
+}
+
+func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	defaultConfig := &keyvaluetags.DefaultConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		defaultConfig.Tags = keyvaluetags.New(v)
+	}
+
+	return defaultConfig
+}
+
+func expandProviderMaxConcurrentRequests(l []interface{}) map[string]int {
+	if len(l) == 0 {
+		return nil
+	}
+
+	limits := make(map[string]int, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		limits[tfMap["name"].(string)] = tfMap["limit"].(int)
+	}
+
+	return limits
+}
+
+func expandProviderRetry(l []interface{}, maxRetries int) *retry.Config {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	retryConfig := &retry.Config{
+		Policy: expandProviderRetryPolicy(m),
+	}
+	retryConfig.Policy.MaxRetries = maxRetries
+
+	if retryConfig.Policy.Mode == "" {
+		retryConfig.Policy.Mode = retry.ModeStandard
+	}
+
+	if v, ok := m["service"].(*schema.Set); ok && v.Len() > 0 {
+		retryConfig.ServicePolicies = make(map[string]*retry.Policy, v.Len())
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			policy := expandProviderRetryPolicy(tfMap)
+
+			if v, ok := tfMap["max_retries"].(int); ok && v != 0 {
+				policy.MaxRetries = v
+			}
+
+			retryConfig.ServicePolicies[tfMap["name"].(string)] = &policy
+		}
+	}
+
+	return retryConfig
+}
+
+func expandProviderRetryPolicy(m map[string]interface{}) retry.Policy {
+	policy := retry.Policy{}
+
+	if v, ok := m["burst"].(int); ok && v != 0 {
+		policy.Burst = v
+	}
+
+	if v, ok := m["max_backoff_seconds"].(int); ok && v != 0 {
+		policy.MaxBackoff = time.Duration(v) * time.Second
+	}
+
+	if v, ok := m["mode"].(string); ok && v != "" {
+		policy.Mode = v
+	}
+
+	if v, ok := m["rate_limit"].(float64); ok && v != 0 {
+		policy.RateLimit = v
+	}
+
+	return policy
+}
+
 func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
-	ignoreConfig := &keyvaluetags.IgnoreConfig{}
 	m := l[0].(map[string]interface{})
+	ignoreConfig := expandProviderIgnoreTagsConfig(m)
+
+	if v, ok := m["resource_type"].(*schema.Set); ok && v.Len() > 0 {
+		ignoreConfig.ResourceTypes = make(map[string]*keyvaluetags.IgnoreConfig, v.Len())
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			resourceType := tfMap["name"].(string)
+			resourceTypeConfig := expandProviderIgnoreTagsConfig(tfMap)
+
+			// Multiple blocks for the same resource type are combined.
+			if existing, ok := ignoreConfig.ResourceTypes[resourceType]; ok {
+				resourceTypeConfig.Keys = existing.Keys.Merge(resourceTypeConfig.Keys)
+				resourceTypeConfig.KeyPrefixes = existing.KeyPrefixes.Merge(resourceTypeConfig.KeyPrefixes)
+				resourceTypeConfig.KeyRegexes = append(existing.KeyRegexes, resourceTypeConfig.KeyRegexes...)
+			}
+
+			ignoreConfig.ResourceTypes[resourceType] = resourceTypeConfig
+		}
+	}
+
+	return ignoreConfig
+}
+
+func expandProviderIgnoreTagsConfig(m map[string]interface{}) *keyvaluetags.IgnoreConfig {
+	ignoreConfig := &keyvaluetags.IgnoreConfig{}
 
 	if v, ok := m["keys"].(*schema.Set); ok {
 		ignoreConfig.Keys = keyvaluetags.New(v.List())
@@ -1484,5 +2067,12 @@ func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = keyvaluetags.New(v.List())
 	}
 
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		for _, keyRegex := range v.List() {
+			// Validated by the schema.
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, regexp.MustCompile(keyRegex.(string)))
+		}
+	}
+
 	return ignoreConfig
 }
@@ -21,6 +21,8 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(AWSAMIDeleteRetryTimeout),
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"architecture": {
 				Type:     schema.TypeString,
@@ -163,7 +165,8 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"virtualization_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -183,6 +186,9 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 }
 
 func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
 	client := meta.(*AWSClient).ec2conn
 
 	req := &ec2.CreateImageInput{
@@ -201,8 +207,8 @@ func resourceAwsAmiFromInstanceCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(id)
 	d.Set("manage_ebs_snapshots", true)
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		if err := keyvaluetags.Ec2CreateTags(client, id, v); err != nil {
+	if len(tags) > 0 {
+		if err := keyvaluetags.Ec2CreateTags(client, id, tags); err != nil {
 			return fmt.Errorf("error adding tags: %s", err)
 		}
 	}
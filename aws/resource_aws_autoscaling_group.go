@@ -15,12 +15,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/autoscaling/waiter"
 )
 
 const (
@@ -38,6 +40,7 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -204,6 +207,54 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 				},
 			},
 
+			"instance_refresh": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preferences": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_warmup": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"min_healthy_percentage": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      90,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+						"strategy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(autoscaling.RefreshStrategy_Values(), false),
+						},
+						"triggers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAutoScalingGroupInstanceRefreshTriggerFields,
+							},
+						},
+						"wait_for_completion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"capacity_rebalance": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -1183,6 +1234,23 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		// Launch configuration changes always trigger an instance refresh.
+		shouldRefreshInstances := d.HasChanges("launch_configuration", "launch_template", "mixed_instances_policy")
+
+		if v, ok := tfMap["triggers"].(*schema.Set); ok && v.Len() > 0 {
+			shouldRefreshInstances = shouldRefreshInstances || d.HasChanges(aws.StringValueSlice(expandStringSet(v))...)
+		}
+
+		if shouldRefreshInstances {
+			if err := autoScalingGroupRefreshInstances(conn, d.Id(), tfMap, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error refreshing Auto Scaling Group (%s) instances: %w", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceAwsAutoscalingGroupRead(d, meta)
 }
 
@@ -1795,3 +1863,116 @@ func waitUntilAutoscalingGroupLoadBalancersRemoved(conn *autoscaling.AutoScaling
 
 	return nil
 }
+
+// autoScalingGroupRefreshInstances starts a new instance refresh of the group,
+// cancelling any instance refresh that is already in progress.
+func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName string, tfMap map[string]interface{}, timeout time.Duration) error {
+	input := &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(asgName),
+		Strategy:             aws.String(tfMap["strategy"].(string)),
+	}
+
+	if v, ok := tfMap["preferences"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		input.Preferences = expandAutoScalingGroupInstanceRefreshPreferences(v[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Starting Auto Scaling Group instance refresh: %s", input)
+	output, err := conn.StartInstanceRefresh(input)
+
+	if tfawserr.ErrCodeEquals(err, autoscaling.ErrCodeInstanceRefreshInProgressFault) {
+		if err := cancelAutoScalingGroupInstanceRefresh(conn, asgName); err != nil {
+			return err
+		}
+
+		output, err = conn.StartInstanceRefresh(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error starting instance refresh: %w", err)
+	}
+
+	instanceRefreshID := aws.StringValue(output.InstanceRefreshId)
+
+	log.Printf("[INFO] Started Auto Scaling Group (%s) instance refresh (%s)", asgName, instanceRefreshID)
+
+	if !tfMap["wait_for_completion"].(bool) {
+		return nil
+	}
+
+	if instanceRefresh, err := waiter.InstanceRefreshSuccessful(conn, asgName, instanceRefreshID, timeout); err != nil {
+		if instanceRefresh != nil && aws.StringValue(instanceRefresh.StatusReason) != "" {
+			return fmt.Errorf("error waiting for instance refresh (%s) to complete: %w: %s", instanceRefreshID, err, aws.StringValue(instanceRefresh.StatusReason))
+		}
+
+		return fmt.Errorf("error waiting for instance refresh (%s) to complete: %w", instanceRefreshID, err)
+	}
+
+	return nil
+}
+
+func cancelAutoScalingGroupInstanceRefresh(conn *autoscaling.AutoScaling, asgName string) error {
+	input := &autoscaling.CancelInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(asgName),
+	}
+
+	log.Printf("[DEBUG] Cancelling Auto Scaling Group instance refresh: %s", input)
+	output, err := conn.CancelInstanceRefresh(input)
+
+	if tfawserr.ErrCodeEquals(err, autoscaling.ErrCodeActiveInstanceRefreshNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling instance refresh: %w", err)
+	}
+
+	instanceRefreshID := aws.StringValue(output.InstanceRefreshId)
+
+	if _, err := waiter.InstanceRefreshCancelled(conn, asgName, instanceRefreshID); err != nil {
+		return fmt.Errorf("error waiting for instance refresh (%s) to cancel: %w", instanceRefreshID, err)
+	}
+
+	return nil
+}
+
+func expandAutoScalingGroupInstanceRefreshPreferences(tfMap map[string]interface{}) *autoscaling.RefreshPreferences {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &autoscaling.RefreshPreferences{}
+
+	if v, ok := tfMap["instance_warmup"].(int); ok && v != 0 {
+		apiObject.InstanceWarmup = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_healthy_percentage"].(int); ok {
+		apiObject.MinHealthyPercentage = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func validateAutoScalingGroupInstanceRefreshTriggerFields(i interface{}, path string) (ws []string, es []error) {
+	v, ok := i.(string)
+
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", path))
+		return
+	}
+
+	switch v {
+	case "launch_configuration", "launch_template", "mixed_instances_policy":
+		es = append(es, fmt.Errorf("%s: %q always triggers an instance refresh and cannot be specified", path, v))
+		return
+	case "instance_refresh":
+		es = append(es, fmt.Errorf("%s: %q cannot be specified", path, v))
+		return
+	}
+
+	if _, ok := resourceAwsAutoscalingGroup().Schema[v]; !ok {
+		es = append(es, fmt.Errorf("%s: %q is not a top-level argument of aws_autoscaling_group", path, v))
+	}
+
+	return
+}
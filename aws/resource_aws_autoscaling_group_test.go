@@ -1484,6 +1484,131 @@ func TestAccAWSAutoScalingGroup_LoadBalancers(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_InstanceRefresh(t *testing.T) {
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh(rName, "t3.nano", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.strategy", "Rolling"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.instance_warmup", "10"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.min_healthy_percentage", "0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.triggers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "instance_refresh.0.triggers.*", "tag"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.wait_for_completion", "true"),
+					testAccCheckAutoScalingInstanceRefreshCount(&group, 0),
+				),
+			},
+			{
+				// Launch template version change always triggers an instance refresh.
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh(rName, "t3.micro", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					testAccCheckAutoScalingInstanceRefreshCount(&group, 1),
+					testAccCheckAutoScalingInstanceRefreshStatus(&group, 0, autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+			{
+				// Configured trigger.
+				Config: testAccAWSAutoScalingGroupConfig_InstanceRefresh(rName, "t3.micro", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists(resourceName, &group),
+					testAccCheckAutoScalingInstanceRefreshCount(&group, 2),
+					testAccCheckAutoScalingInstanceRefreshStatus(&group, 0, autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateAutoScalingGroupInstanceRefreshTriggerFields(t *testing.T) {
+	validValues := []string{
+		"availability_zones",
+		"tag",
+		"tags",
+		"vpc_zone_identifier",
+	}
+	for _, v := range validValues {
+		_, errors := validateAutoScalingGroupInstanceRefreshTriggerFields(v, "triggers")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid instance refresh trigger: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"instance_refresh",
+		"launch_configuration",
+		"launch_template",
+		"mixed_instances_policy",
+		"not_an_argument",
+		"launch_template.0.version",
+	}
+	for _, v := range invalidValues {
+		_, errors := validateAutoScalingGroupInstanceRefreshTriggerFields(v, "triggers")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid instance refresh trigger", v)
+		}
+	}
+}
+
+func testAccCheckAutoScalingInstanceRefreshCount(group *autoscaling.Group, expected int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+
+		output, err := conn.DescribeInstanceRefreshes(&autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: group.AutoScalingGroupName,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error describing Auto Scaling Group (%s) instance refreshes: %w", aws.StringValue(group.AutoScalingGroupName), err)
+		}
+
+		if got := len(output.InstanceRefreshes); got != expected {
+			return fmt.Errorf("expected %d instance refreshes, got %d", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutoScalingInstanceRefreshStatus(group *autoscaling.Group, offset int, expected ...string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+
+		output, err := conn.DescribeInstanceRefreshes(&autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: group.AutoScalingGroupName,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error describing Auto Scaling Group (%s) instance refreshes: %w", aws.StringValue(group.AutoScalingGroupName), err)
+		}
+
+		if len(output.InstanceRefreshes) <= offset {
+			return fmt.Errorf("expected at least %d instance refreshes, got %d", offset+1, len(output.InstanceRefreshes))
+		}
+
+		status := aws.StringValue(output.InstanceRefreshes[offset].Status)
+
+		for _, v := range expected {
+			if status == v {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected instance refresh at index %d to be in %q, got %q", offset, expected, status)
+	}
+}
+
 func TestAccAWSAutoScalingGroup_MixedInstancesPolicy(t *testing.T) {
 	var group autoscaling.Group
 	resourceName := "aws_autoscaling_group.test"
@@ -4226,3 +4351,47 @@ resource "aws_autoscaling_group" "test" {
 }
 `, rName)
 }
+
+func testAccAWSAutoScalingGroupConfig_InstanceRefresh(rName, instanceType, tagValue string) string {
+	return composeConfig(
+		testAccLatestAmazonLinuxHvmEbsAmiConfig(),
+		testAccAvailableAZsNoOptInDefaultExcludeConfig(),
+		fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = %[2]q
+  name          = %[1]q
+}
+
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+  desired_capacity   = 1
+  max_size           = 2
+  min_size           = 1
+  name               = %[1]q
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.latest_version
+  }
+
+  instance_refresh {
+    strategy = "Rolling"
+
+    preferences {
+      instance_warmup        = 10
+      min_healthy_percentage = 0
+    }
+
+    triggers            = ["tag"]
+    wait_for_completion = true
+  }
+
+  tag {
+    key                 = "Key"
+    value               = %[3]q
+    propagate_at_launch = true
+  }
+}
+`, rName, instanceType, tagValue))
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -32,6 +33,8 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 		MigrateState:  resourceAwsCloudFrontDistributionMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -53,6 +56,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"cache_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"cached_methods": {
 							Type:     schema.TypeSet,
 							Required: true,
@@ -64,9 +71,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -74,7 +82,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -142,19 +150,30 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
 						},
 						"path_pattern": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -163,6 +182,11 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"trusted_key_groups": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 						"trusted_signers": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -215,6 +239,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"cache_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"cached_methods": {
 							Type:     schema.TypeSet,
 							Required: true,
@@ -226,9 +254,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -236,7 +265,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -304,14 +333,25 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLDiffs,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
+						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
 						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
@@ -321,6 +361,11 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"trusted_key_groups": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 						"trusted_signers": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -485,6 +530,24 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"origin_shield": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"origin_shield_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
 						"s3_origin_config": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -580,6 +643,35 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"trusted_key_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"items": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_group_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"key_pair_ids": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			// Terraform AWS Provider 3.0 name change:
 			// enables TF Plugin SDK to ignore pre-existing attribute state
 			// associated with previous naming i.e. active_trusted_signers
@@ -651,18 +743,21 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Default:  false,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
 func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudfrontconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
 			DistributionConfig: expandDistributionConfig(d),
-			Tags:               &cloudfront.Tags{Items: keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().CloudfrontTags()},
+			Tags:               &cloudfront.Tags{Items: tags.IgnoreAws().CloudfrontTags()},
 		},
 	}
 
@@ -709,6 +804,7 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudfrontconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	params := &cloudfront.GetDistributionInput{
 		Id: aws.String(d.Id()),
@@ -732,6 +828,9 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 	}
 
 	// Update other attributes outside of DistributionConfig
+	if err := d.Set("trusted_key_groups", flattenCloudfrontActiveTrustedKeyGroups(resp.Distribution.ActiveTrustedKeyGroups)); err != nil {
+		return fmt.Errorf("error setting trusted_key_groups: %w", err)
+	}
 	if err := d.Set("trusted_signers", flattenCloudfrontActiveTrustedSigners(resp.Distribution.ActiveTrustedSigners)); err != nil {
 		return fmt.Errorf("error setting trusted_signers: %w", err)
 	}
@@ -746,10 +845,17 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return fmt.Errorf("error listing tags for CloudFront Distribution (%s): %s", d.Id(), err)
 	}
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -794,8 +900,8 @@ func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.CloudfrontUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags for CloudFront Distribution (%s): %s", d.Id(), err)
 		}
@@ -1008,3 +1114,13 @@ func resourceAwsCloudFrontWebDistributionStateRefreshFunc(id string, meta interf
 		return resp.Distribution, *resp.Distribution.Status, nil
 	}
 }
+
+// suppressCloudFrontCacheBehaviorTTLDiffs suppresses differences in the legacy
+// cache behavior TTL settings when a cache policy is attached, as the TTLs are
+// then managed by the cache policy and are not returned by the API.
+func suppressCloudFrontCacheBehaviorTTLDiffs(k, old, new string, d *schema.ResourceData) bool {
+	parts := strings.Split(k, ".")
+	parts[len(parts)-1] = "cache_policy_id"
+
+	return d.Get(strings.Join(parts, ".")).(string) != ""
+}
@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudFrontKeyGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontKeyGroupCreate,
+		Read:   resourceAwsCloudFrontKeyGroupRead,
+		Update: resourceAwsCloudFrontKeyGroupUpdate,
+		Delete: resourceAwsCloudFrontKeyGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"items": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontKeyGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateKeyGroupInput{
+		KeyGroupConfig: expandCloudFrontKeyGroupConfig(d),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Key Group: %s", input)
+	output, err := conn.CreateKeyGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Key Group (%s): %w", name, err)
+	}
+
+	if output == nil || output.KeyGroup == nil {
+		return fmt.Errorf("error creating CloudFront Key Group (%s): empty output", name)
+	}
+
+	d.SetId(aws.StringValue(output.KeyGroup.Id))
+
+	return resourceAwsCloudFrontKeyGroupRead(d, meta)
+}
+
+func resourceAwsCloudFrontKeyGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	output, err := finder.KeyGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Key Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Key Group (%s): %w", d.Id(), err)
+	}
+
+	keyGroupConfig := output.KeyGroup.KeyGroupConfig
+
+	d.Set("comment", keyGroupConfig.Comment)
+	d.Set("etag", output.ETag)
+	d.Set("name", keyGroupConfig.Name)
+
+	if err := d.Set("items", flattenStringSet(keyGroupConfig.Items)); err != nil {
+		return fmt.Errorf("error setting items: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFrontKeyGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdateKeyGroupInput{
+		Id:             aws.String(d.Id()),
+		IfMatch:        aws.String(d.Get("etag").(string)),
+		KeyGroupConfig: expandCloudFrontKeyGroupConfig(d),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Key Group: %s", input)
+	_, err := conn.UpdateKeyGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Key Group (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontKeyGroupRead(d, meta)
+}
+
+func resourceAwsCloudFrontKeyGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Key Group: %s", d.Id())
+	_, err := conn.DeleteKeyGroup(&cloudfront.DeleteKeyGroupInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchResource) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Key Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontKeyGroupConfig(d *schema.ResourceData) *cloudfront.KeyGroupConfig {
+	keyGroupConfig := &cloudfront.KeyGroupConfig{
+		Items: expandStringSet(d.Get("items").(*schema.Set)),
+		Name:  aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		keyGroupConfig.Comment = aws.String(v.(string))
+	}
+
+	return keyGroupConfig
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCloudFrontKeyGroup_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_key_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontKeyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "test key group"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "items.*", "aws_cloudfront_public_key.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontKeyGroup_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_key_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontKeyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFrontKeyGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontKeyGroup_Comment(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_key_group.test"
+
+	firstComment := "first comment"
+	secondComment := "second comment"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontKeyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfigComment(rName, firstComment),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", firstComment),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfigComment(rName, secondComment),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", secondComment),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontKeyGroup_Items(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudfront_key_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontKeyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "items.*", "aws_cloudfront_public_key.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontKeyGroupConfigItems(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontKeyGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "items.*", "aws_cloudfront_public_key.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "items.*", "aws_cloudfront_public_key.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFrontKeyGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_key_group" {
+			continue
+		}
+
+		_, err := finder.KeyGroupByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Key Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFrontKeyGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Key Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		_, err := finder.KeyGroupByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAWSCloudFrontKeyGroupConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  comment     = "test key"
+  encoded_key = file("test-fixtures/cloudfront-public-key.pem")
+  name        = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSCloudFrontKeyGroupConfig(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontKeyGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_key_group" "test" {
+  comment = "test key group"
+  items   = [aws_cloudfront_public_key.test.id]
+  name    = %[1]q
+}
+`, rName))
+}
+
+func testAccAWSCloudFrontKeyGroupConfigComment(rName string, comment string) string {
+	return composeConfig(
+		testAccAWSCloudFrontKeyGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_key_group" "test" {
+  comment = %[2]q
+  items   = [aws_cloudfront_public_key.test.id]
+  name    = %[1]q
+}
+`, rName, comment))
+}
+
+func testAccAWSCloudFrontKeyGroupConfigItems(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontKeyGroupConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test2" {
+  comment     = "second test key"
+  encoded_key = file("test-fixtures/cloudfront-public-key.pem")
+  name        = "%[1]s-second"
+}
+
+resource "aws_cloudfront_key_group" "test" {
+  comment = "test key group"
+  items   = [aws_cloudfront_public_key.test.id, aws_cloudfront_public_key.test2.id]
+  name    = %[1]q
+}
+`, rName))
+}
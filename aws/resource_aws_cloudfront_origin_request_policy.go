@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudFrontOriginRequestPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontOriginRequestPolicyCreate,
+		Read:   resourceAwsCloudFrontOriginRequestPolicyRead,
+		Update: resourceAwsCloudFrontOriginRequestPolicyUpdate,
+		Delete: resourceAwsCloudFrontOriginRequestPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cookies_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cookie_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudfront.OriginRequestPolicyCookieBehavior_Values(), false),
+						},
+						"cookies": cloudFrontPolicyItemsSchema(),
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"headers_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"header_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudfront.OriginRequestPolicyHeaderBehavior_Values(), false),
+						},
+						"headers": cloudFrontPolicyItemsSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query_strings_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query_string_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudfront.OriginRequestPolicyQueryStringBehavior_Values(), false),
+						},
+						"query_strings": cloudFrontPolicyItemsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateOriginRequestPolicyInput{
+		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Origin Request Policy: %s", input)
+	output, err := conn.CreateOriginRequestPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Origin Request Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.OriginRequestPolicy.Id))
+
+	return resourceAwsCloudFrontOriginRequestPolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	output, err := finder.OriginRequestPolicyByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Origin Request Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Request Policy (%s): %w", d.Id(), err)
+	}
+
+	return setCloudFrontOriginRequestPolicyConfig(d, output.OriginRequestPolicy.OriginRequestPolicyConfig, output.ETag)
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdateOriginRequestPolicyInput{
+		Id:                        aws.String(d.Id()),
+		IfMatch:                   aws.String(d.Get("etag").(string)),
+		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Origin Request Policy: %s", input)
+	_, err := conn.UpdateOriginRequestPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Origin Request Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontOriginRequestPolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Origin Request Policy: %s", d.Id())
+	_, err := conn.DeleteOriginRequestPolicy(&cloudfront.DeleteOriginRequestPolicyInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginRequestPolicy) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Origin Request Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontOriginRequestPolicyConfig(d *schema.ResourceData) *cloudfront.OriginRequestPolicyConfig {
+	apiObject := &cloudfront.OriginRequestPolicyConfig{
+		Name: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		apiObject.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cookies_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.CookiesConfig = &cloudfront.OriginRequestPolicyCookiesConfig{
+			CookieBehavior: aws.String(tfMap["cookie_behavior"].(string)),
+		}
+
+		if v, ok := tfMap["cookies"].([]interface{}); ok {
+			apiObject.CookiesConfig.Cookies = expandCloudFrontCookieNames(v)
+		}
+	}
+
+	if v, ok := d.GetOk("headers_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.HeadersConfig = &cloudfront.OriginRequestPolicyHeadersConfig{
+			HeaderBehavior: aws.String(tfMap["header_behavior"].(string)),
+		}
+
+		if v, ok := tfMap["headers"].([]interface{}); ok {
+			apiObject.HeadersConfig.Headers = expandCloudFrontPolicyHeaders(v)
+		}
+	}
+
+	if v, ok := d.GetOk("query_strings_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.QueryStringsConfig = &cloudfront.OriginRequestPolicyQueryStringsConfig{
+			QueryStringBehavior: aws.String(tfMap["query_string_behavior"].(string)),
+		}
+
+		if v, ok := tfMap["query_strings"].([]interface{}); ok {
+			apiObject.QueryStringsConfig.QueryStrings = expandCloudFrontQueryStringNames(v)
+		}
+	}
+
+	return apiObject
+}
+
+// setCloudFrontOriginRequestPolicyConfig sets the attributes shared by the
+// aws_cloudfront_origin_request_policy resource and data source.
+func setCloudFrontOriginRequestPolicyConfig(d *schema.ResourceData, apiObject *cloudfront.OriginRequestPolicyConfig, etag *string) error {
+	d.Set("comment", apiObject.Comment)
+	d.Set("etag", etag)
+	d.Set("name", apiObject.Name)
+
+	var cookiesConfig, headersConfig, queryStringsConfig []interface{}
+
+	if v := apiObject.CookiesConfig; v != nil {
+		cookiesConfig = []interface{}{map[string]interface{}{
+			"cookie_behavior": aws.StringValue(v.CookieBehavior),
+			"cookies":         flattenCloudFrontCookieNames(v.Cookies),
+		}}
+	}
+
+	if err := d.Set("cookies_config", cookiesConfig); err != nil {
+		return fmt.Errorf("error setting cookies_config: %w", err)
+	}
+
+	if v := apiObject.HeadersConfig; v != nil {
+		headersConfig = []interface{}{map[string]interface{}{
+			"header_behavior": aws.StringValue(v.HeaderBehavior),
+			"headers":         flattenCloudFrontPolicyHeaders(v.Headers),
+		}}
+	}
+
+	if err := d.Set("headers_config", headersConfig); err != nil {
+		return fmt.Errorf("error setting headers_config: %w", err)
+	}
+
+	if v := apiObject.QueryStringsConfig; v != nil {
+		queryStringsConfig = []interface{}{map[string]interface{}{
+			"query_string_behavior": aws.StringValue(v.QueryStringBehavior),
+			"query_strings":         flattenCloudFrontQueryStringNames(v.QueryStrings),
+		}}
+	}
+
+	if err := d.Set("query_strings_config", queryStringsConfig); err != nil {
+		return fmt.Errorf("error setting query_strings_config: %w", err)
+	}
+
+	return nil
+}
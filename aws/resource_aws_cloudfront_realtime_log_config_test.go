@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSCloudFrontRealtimeLogConfig_basic(t *testing.T) {
+	var v cloudfront.RealtimeLogConfig
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	samplingRate := acctest.RandIntRange(1, 100)
+	resourceName := "aws_cloudfront_realtime_log_config.test"
+	roleResourceName := "aws_iam_role.test.0"
+	streamResourceName := "aws_kinesis_stream.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontRealtimeLogConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontRealtimeLogConfigConfig(rName, samplingRate, 0, `["timestamp", "c-ip"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontRealtimeLogConfigExists(resourceName, &v),
+					testAccCheckResourceAttrGlobalARN(resourceName, "arn", "cloudfront", fmt.Sprintf("realtime-log-config/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.kinesis_stream_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint.0.kinesis_stream_config.0.role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint.0.kinesis_stream_config.0.stream_arn", streamResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.stream_type", "Kinesis"),
+					resource.TestCheckResourceAttr(resourceName, "fields.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields.*", "timestamp"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields.*", "c-ip"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sampling_rate", fmt.Sprintf("%d", samplingRate)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontRealtimeLogConfig_disappears(t *testing.T) {
+	var v cloudfront.RealtimeLogConfig
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	samplingRate := acctest.RandIntRange(1, 100)
+	resourceName := "aws_cloudfront_realtime_log_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontRealtimeLogConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontRealtimeLogConfigConfig(rName, samplingRate, 0, `["timestamp", "c-ip"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontRealtimeLogConfigExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFrontRealtimeLogConfig(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontRealtimeLogConfig_updates(t *testing.T) {
+	var v cloudfront.RealtimeLogConfig
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	samplingRate1 := acctest.RandIntRange(1, 50)
+	samplingRate2 := acctest.RandIntRange(51, 100)
+	resourceName := "aws_cloudfront_realtime_log_config.test"
+	role1ResourceName := "aws_iam_role.test.0"
+	stream1ResourceName := "aws_kinesis_stream.test.0"
+	role2ResourceName := "aws_iam_role.test.1"
+	stream2ResourceName := "aws_kinesis_stream.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontRealtimeLogConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontRealtimeLogConfigConfig(rName, samplingRate1, 0, `["timestamp", "c-ip"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontRealtimeLogConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint.0.kinesis_stream_config.0.role_arn", role1ResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint.0.kinesis_stream_config.0.stream_arn", stream1ResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sampling_rate", fmt.Sprintf("%d", samplingRate1)),
+				),
+			},
+			{
+				Config: testAccAWSCloudFrontRealtimeLogConfigConfig(rName, samplingRate2, 1, `["c-ip", "cs-host", "sc-status"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontRealtimeLogConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint.0.kinesis_stream_config.0.role_arn", role2ResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint.0.kinesis_stream_config.0.stream_arn", stream2ResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "fields.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields.*", "c-ip"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields.*", "cs-host"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields.*", "sc-status"),
+					resource.TestCheckResourceAttr(resourceName, "sampling_rate", fmt.Sprintf("%d", samplingRate2)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFrontRealtimeLogConfigDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_realtime_log_config" {
+			continue
+		}
+
+		_, err := finder.RealtimeLogConfigByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Real-time Log Config %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFrontRealtimeLogConfigExists(n string, v *cloudfront.RealtimeLogConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Real-time Log Config ARN is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		output, err := finder.RealtimeLogConfigByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSCloudFrontRealtimeLogConfigConfigBase(rName string, count int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_kinesis_stream" "test" {
+  count = %[2]d
+
+  name        = format("%%s-%%d", %[1]q, count.index)
+  shard_count = 2
+}
+
+resource "aws_iam_role" "test" {
+  count = %[2]d
+
+  name = format("%%s-%%d", %[1]q, count.index)
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "cloudfront.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  count = %[2]d
+
+  name = format("%%s-%%d", %[1]q, count.index)
+  role = aws_iam_role.test[count.index].id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": [
+      "kinesis:DescribeStreamSummary",
+      "kinesis:DescribeStream",
+      "kinesis:PutRecord",
+      "kinesis:PutRecords"
+    ],
+    "Resource": "${aws_kinesis_stream.test[count.index].arn}"
+  }]
+}
+EOF
+}
+`, rName, count)
+}
+
+func testAccAWSCloudFrontRealtimeLogConfigConfig(rName string, samplingRate, index int, fields string) string {
+	return composeConfig(
+		testAccAWSCloudFrontRealtimeLogConfigConfigBase(rName, 2),
+		fmt.Sprintf(`
+resource "aws_cloudfront_realtime_log_config" "test" {
+  name          = %[1]q
+  sampling_rate = %[2]d
+  fields        = %[4]s
+
+  endpoint {
+    stream_type = "Kinesis"
+
+    kinesis_stream_config {
+      role_arn   = aws_iam_role.test[%[3]d].arn
+      stream_arn = aws_kinesis_stream.test[%[3]d].arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, samplingRate, index, fields))
+}
@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/finder"
+)
+
+func resourceAwsCloudWatchEventArchive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventArchiveCreate,
+		Read:   resourceAwsCloudWatchEventArchiveRead,
+		Update: resourceAwsCloudWatchEventArchiveUpdate,
+		Delete: resourceAwsCloudWatchEventArchiveDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 48),
+					validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+$`), ""),
+				),
+			},
+			"event_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEventPatternValue(),
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v.(string))
+					return json
+				},
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventArchiveCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input, err := buildCreateArchiveInputStruct(d)
+	if err != nil {
+		return fmt.Errorf("Creating CloudWatch Events Archive parameters failed: %w", err)
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Events Archive: %s", input)
+
+	_, err = conn.CreateArchive(input)
+	if err != nil {
+		return fmt.Errorf("Creating CloudWatch Events Archive (%s) failed: %w", aws.StringValue(input.ArchiveName), err)
+	}
+
+	d.SetId(aws.StringValue(input.ArchiveName))
+
+	log.Printf("[INFO] CloudWatch Events Archive (%s) created", d.Id())
+
+	return resourceAwsCloudWatchEventArchiveRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventArchiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[DEBUG] Reading CloudWatch Events Archive (%s)", d.Id())
+	out, err := finder.Archive(conn, d.Id())
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CloudWatch Events Archive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Events Archive (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", out.ArchiveArn)
+	d.Set("description", out.Description)
+	d.Set("event_source_arn", out.EventSourceArn)
+	d.Set("name", out.ArchiveName)
+	d.Set("retention_days", out.RetentionDays)
+
+	if out.EventPattern != nil {
+		pattern, err := structure.NormalizeJsonString(aws.StringValue(out.EventPattern))
+		if err != nil {
+			return fmt.Errorf("event pattern contains an invalid JSON: %w", err)
+		}
+		d.Set("event_pattern", pattern)
+	} else {
+		d.Set("event_pattern", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventArchiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.UpdateArchiveInput{
+		ArchiveName:   aws.String(d.Id()),
+		Description:   aws.String(d.Get("description").(string)),
+		RetentionDays: aws.Int64(int64(d.Get("retention_days").(int))),
+	}
+
+	if v, ok := d.GetOk("event_pattern"); ok {
+		pattern, err := structure.NormalizeJsonString(v)
+		if err != nil {
+			return fmt.Errorf("event pattern contains an invalid JSON: %w", err)
+		}
+		input.EventPattern = aws.String(pattern)
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Events Archive: %s", input)
+	_, err := conn.UpdateArchive(input)
+	if err != nil {
+		return fmt.Errorf("error updating CloudWatch Events Archive (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchEventArchiveRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventArchiveDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	log.Printf("[INFO] Deleting CloudWatch Events Archive (%s)", d.Id())
+	_, err := conn.DeleteArchive(&events.DeleteArchiveInput{
+		ArchiveName: aws.String(d.Id()),
+	})
+	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Events Archive (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func buildCreateArchiveInputStruct(d *schema.ResourceData) (*events.CreateArchiveInput, error) {
+	input := &events.CreateArchiveInput{
+		ArchiveName:    aws.String(d.Get("name").(string)),
+		EventSourceArn: aws.String(d.Get("event_source_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("event_pattern"); ok {
+		pattern, err := structure.NormalizeJsonString(v)
+		if err != nil {
+			return nil, fmt.Errorf("event pattern contains an invalid JSON: %w", err)
+		}
+		input.EventPattern = aws.String(pattern)
+	}
+
+	if v, ok := d.GetOk("retention_days"); ok {
+		input.RetentionDays = aws.Int64(int64(v.(int)))
+	}
+
+	return input, nil
+}
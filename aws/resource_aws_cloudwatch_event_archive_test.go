@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/lister"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
+		Name: "aws_cloudwatch_event_archive",
+		F:    testSweepCloudWatchEventArchives,
+	})
+}
+
+func testSweepCloudWatchEventArchives(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).cloudwatcheventsconn
+
+	var sweeperErrs *multierror.Error
+
+	err = lister.ListArchivesPages(conn, &events.ListArchivesInput{}, func(page *events.ListArchivesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, archive := range page.Archives {
+			name := aws.StringValue(archive.ArchiveName)
+
+			log.Printf("[INFO] Deleting CloudWatch Events archive (%s)", name)
+			_, err := conn.DeleteArchive(&events.DeleteArchiveInput{
+				ArchiveName: aws.String(name),
+			})
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting CloudWatch Events archive (%s): %w", name, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudWatch Events archive sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error retrieving CloudWatch Events archives: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSCloudWatchEventArchive_basic(t *testing.T) {
+	var v events.DescribeArchiveOutput
+	archiveName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfig(archiveName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", archiveName),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "0"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "events", fmt.Sprintf("archive/%s", archiveName)),
+					resource.TestCheckResourceAttr(resourceName, "event_pattern", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "event_source_arn", "aws_cloudwatch_event_bus.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventArchive_update(t *testing.T) {
+	var v events.DescribeArchiveOutput
+	archiveName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfig(archiveName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "0"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfigUpdated(archiveName, "test", 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_days", "7"),
+					testAccCheckResourceAttrEquivalentJSON(resourceName, "event_pattern", "{\"source\":[\"company.team.service\"]}"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventArchive_disappears(t *testing.T) {
+	var v events.DescribeArchiveOutput
+	archiveName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventArchiveConfig(archiveName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventArchiveExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchEventArchive(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchEventArchiveDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_archive" {
+			continue
+		}
+
+		_, err := finder.Archive(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Events archive (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCloudWatchEventArchiveExists(n string, v *events.DescribeArchiveOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+		output, err := finder.Archive(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSCloudWatchEventArchiveConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_bus.test.arn
+}
+`, name)
+}
+
+func testAccAWSCloudWatchEventArchiveConfigUpdated(name, description string, retentionDays int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_bus.test.arn
+  description      = %[2]q
+  retention_days   = %[3]d
+
+  event_pattern = <<PATTERN
+{
+  "source": ["company.team.service"]
+}
+PATTERN
+}
+`, name, description, retentionDays)
+}
@@ -224,6 +224,31 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 				},
 			},
 
+			"http_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"header_parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"path_parameter_values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"query_string_parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
 			"input_transformer": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -344,6 +369,12 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if t.HttpParameters != nil {
+		if err := d.Set("http_target", flattenAwsCloudWatchEventTargetHttpParameters(t.HttpParameters)); err != nil {
+			return fmt.Errorf("Error setting http_target error: %w", err)
+		}
+	}
+
 	if t.InputTransformer != nil {
 		if err := d.Set("input_transformer", flattenAwsCloudWatchInputTransformer(t.InputTransformer)); err != nil {
 			return fmt.Errorf("Error setting input_transformer error: %w", err)
@@ -430,6 +461,10 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 		e.SqsParameters = expandAwsCloudWatchEventTargetSqsParameters(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("http_target"); ok {
+		e.HttpParameters = expandAwsCloudWatchEventTargetHttpParameters(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("input_transformer"); ok {
 		e.InputTransformer = expandAwsCloudWatchEventTransformerParameters(v.([]interface{}))
 	}
@@ -550,6 +585,27 @@ func expandAwsCloudWatchEventTargetSqsParameters(config []interface{}) *events.S
 	return sqsParameters
 }
 
+func expandAwsCloudWatchEventTargetHttpParameters(config []interface{}) *events.HttpParameters {
+	httpParameters := &events.HttpParameters{}
+	for _, c := range config {
+		param, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v, ok := param["header_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			httpParameters.HeaderParameters = stringMapToPointers(v)
+		}
+		if v, ok := param["path_parameter_values"].([]interface{}); ok && len(v) > 0 {
+			httpParameters.PathParameterValues = expandStringList(v)
+		}
+		if v, ok := param["query_string_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			httpParameters.QueryStringParameters = stringMapToPointers(v)
+		}
+	}
+
+	return httpParameters
+}
+
 func expandAwsCloudWatchEventTransformerParameters(config []interface{}) *events.InputTransformer {
 	transformerParameters := &events.InputTransformer{}
 
@@ -645,6 +701,15 @@ func flattenAwsCloudWatchEventTargetSqsParameters(sqsParameters *events.SqsParam
 	return result
 }
 
+func flattenAwsCloudWatchEventTargetHttpParameters(httpParameters *events.HttpParameters) []map[string]interface{} {
+	config := make(map[string]interface{})
+	config["header_parameters"] = aws.StringValueMap(httpParameters.HeaderParameters)
+	config["path_parameter_values"] = aws.StringValueSlice(httpParameters.PathParameterValues)
+	config["query_string_parameters"] = aws.StringValueMap(httpParameters.QueryStringParameters)
+	result := []map[string]interface{}{config}
+	return result
+}
+
 func flattenAwsCloudWatchInputTransformer(inputTransformer *events.InputTransformer) []map[string]interface{} {
 	config := make(map[string]interface{})
 	inputPathsMap := make(map[string]string)
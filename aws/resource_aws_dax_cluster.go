@@ -32,6 +32,8 @@ func resourceAwsDaxCluster() *schema.Resource {
 			Update: schema.DefaultTimeout(90 * time.Minute),
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -130,7 +132,8 @@ func resourceAwsDaxCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"port": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -173,6 +176,7 @@ func resourceAwsDaxCluster() *schema.Resource {
 
 func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).daxconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	clusterName := d.Get("cluster_name").(string)
 	iamRoleArn := d.Get("iam_role_arn").(string)
@@ -182,7 +186,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	securityIdSet := d.Get("security_group_ids").(*schema.Set)
 
 	securityIds := expandStringList(securityIdSet.List())
-	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().DaxTags()
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{}))).IgnoreAws().DaxTags()
 
 	req := &dax.CreateClusterInput{
 		ClusterName:       aws.String(clusterName),
@@ -272,6 +276,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).daxconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	req := &dax.DescribeClustersInput{
 		ClusterNames: []*string{aws.String(d.Id())},
@@ -337,18 +342,25 @@ func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error listing tags for DAX Cluster (%s): %s", aws.StringValue(c.ClusterArn), err)
 	}
 
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).daxconn
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.DaxUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating DAX Cluster (%s) tags: %s", d.Get("arn").(string), err)
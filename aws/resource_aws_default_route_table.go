@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 )
 
 func resourceAwsDefaultRouteTable() *schema.Resource {
@@ -24,6 +25,8 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"default_route_table_id": {
 				Type:     schema.TypeString,
@@ -112,7 +115,8 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 				Set: resourceAwsRouteTableHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 
 			"owner_id": {
 				Type:     schema.TypeString,
@@ -126,15 +130,13 @@ func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}
 	d.SetId(d.Get("default_route_table_id").(string))
 
 	conn := meta.(*AWSClient).ec2conn
-	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, d.Id())()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	rt, err := finder.RouteTableByID(conn, d.Id())
 	if err != nil {
-		return fmt.Errorf("error reading EC2 Default Route Table (%s): %s", d.Id(), err)
+		return fmt.Errorf("error reading EC2 Default Route Table (%s): %w", d.Id(), err)
 	}
-	if rtRaw == nil {
-		return fmt.Errorf("error reading EC2 Default Route Table (%s): not found", d.Id())
-	}
-
-	rt := rtRaw.(*ec2.RouteTable)
 
 	d.Set("vpc_id", rt.VpcId)
 
@@ -145,8 +147,8 @@ func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		if err := keyvaluetags.Ec2CreateTags(conn, d.Id(), v); err != nil {
+	if len(tags) > 0 {
+		if err := keyvaluetags.Ec2CreateTags(conn, d.Id(), tags); err != nil {
 			return fmt.Errorf("error adding tags: %s", err)
 		}
 	}
@@ -23,6 +23,8 @@ func resourceAwsDxConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -48,7 +50,8 @@ func resourceAwsDxConnection() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"has_logical_redundancy": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -63,6 +66,8 @@ func resourceAwsDxConnection() *schema.Resource {
 
 func resourceAwsDxConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dxconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	req := &directconnect.CreateConnectionInput{
 		Bandwidth:      aws.String(d.Get("bandwidth").(string)),
@@ -70,8 +75,8 @@ func resourceAwsDxConnectionCreate(d *schema.ResourceData, meta interface{}) err
 		Location:       aws.String(d.Get("location").(string)),
 	}
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		req.Tags = keyvaluetags.New(v).IgnoreAws().DirectconnectTags()
+	if len(tags) > 0 {
+		req.Tags = tags.IgnoreAws().DirectconnectTags()
 	}
 
 	log.Printf("[DEBUG] Creating Direct Connect connection: %#v", req)
@@ -88,6 +93,7 @@ func resourceAwsDxConnectionCreate(d *schema.ResourceData, meta interface{}) err
 func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dxconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	resp, err := conn.DescribeConnections(&directconnect.DescribeConnectionsInput{
 		ConnectionId: aws.String(d.Id()),
@@ -140,10 +146,17 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error listing tags for Direct Connect connection (%s): %s", arn, err)
 	}
 
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -151,8 +164,8 @@ func resourceAwsDxConnectionUpdate(d *schema.ResourceData, meta interface{}) err
 	conn := meta.(*AWSClient).dxconn
 
 	arn := d.Get("arn").(string)
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.DirectconnectUpdateTags(conn, arn, o, n); err != nil {
 			return fmt.Errorf("error updating Direct Connect connection (%s) tags: %s", arn, err)
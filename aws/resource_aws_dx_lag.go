@@ -23,6 +23,8 @@ func resourceAwsDxLag() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -52,7 +54,8 @@ func resourceAwsDxLag() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"has_logical_redundancy": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -63,6 +66,8 @@ func resourceAwsDxLag() *schema.Resource {
 
 func resourceAwsDxLagCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dxconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	req := &directconnect.CreateLagInput{
 		ConnectionsBandwidth: aws.String(d.Get("connections_bandwidth").(string)),
@@ -71,8 +76,8 @@ func resourceAwsDxLagCreate(d *schema.ResourceData, meta interface{}) error {
 		NumberOfConnections:  aws.Int64(int64(1)),
 	}
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		req.Tags = keyvaluetags.New(v).IgnoreAws().DirectconnectTags()
+	if len(tags) > 0 {
+		req.Tags = tags.IgnoreAws().DirectconnectTags()
 	}
 
 	log.Printf("[DEBUG] Creating Direct Connect LAG: %#v", req)
@@ -100,6 +105,7 @@ func resourceAwsDxLagCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dxconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	resp, err := conn.DescribeLags(&directconnect.DescribeLagsInput{
 		LagId: aws.String(d.Id()),
@@ -152,10 +158,17 @@ func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error listing tags for Direct Connect LAG (%s): %s", arn, err)
 	}
 
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -176,8 +189,8 @@ func resourceAwsDxLagUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	arn := d.Get("arn").(string)
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.DirectconnectUpdateTags(conn, arn, o, n); err != nil {
 			return fmt.Errorf("error updating Direct Connect LAG (%s) tags: %s", arn, err)
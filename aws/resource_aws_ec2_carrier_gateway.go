@@ -25,6 +25,8 @@ func resourceAwsEc2CarrierGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -36,7 +38,8 @@ func resourceAwsEc2CarrierGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -49,9 +52,11 @@ func resourceAwsEc2CarrierGateway() *schema.Resource {
 
 func resourceAwsEc2CarrierGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &ec2.CreateCarrierGatewayInput{
-		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), "carrier-gateway"),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, "carrier-gateway"),
 		VpcId:             aws.String(d.Get("vpc_id").(string)),
 	}
 
@@ -76,6 +81,7 @@ func resourceAwsEc2CarrierGatewayCreate(d *schema.ResourceData, meta interface{}
 func resourceAwsEc2CarrierGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	carrierGateway, err := finder.CarrierGatewayByID(conn, d.Id())
 
@@ -106,18 +112,25 @@ func resourceAwsEc2CarrierGatewayRead(d *schema.ResourceData, meta interface{})
 	d.Set("owner_id", carrierGateway.OwnerId)
 	d.Set("vpc_id", carrierGateway.VpcId)
 
-	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(carrierGateway.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.Ec2KeyValueTags(carrierGateway.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
 	return nil
 }
 
 func resourceAwsEc2CarrierGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating EC2 Carrier Gateway (%s) tags: %w", d.Id(), err)
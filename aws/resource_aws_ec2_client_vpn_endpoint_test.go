@@ -13,7 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/terraform-providers/terraform-provider-aws/aws/internal/experimental/sync"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sync"
 )
 
 const clientVpnEndpointDefaultLimit = 5
@@ -22,6 +22,8 @@ func resourceAwsEcsCapacityProvider() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsEcsCapacityProviderImport,
 		},
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -99,13 +101,16 @@ func resourceAwsEcsCapacityProvider() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
 func resourceAwsEcsCapacityProviderCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	input := ecs.CreateCapacityProviderInput{
 		Name:                     aws.String(d.Get("name").(string)),
@@ -113,8 +118,8 @@ func resourceAwsEcsCapacityProviderCreate(d *schema.ResourceData, meta interface
 	}
 
 	// `CreateCapacityProviderInput` does not accept an empty array of tags
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		input.Tags = keyvaluetags.New(v).IgnoreAws().EcsTags()
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().EcsTags()
 	}
 
 	out, err := conn.CreateCapacityProvider(&input)
@@ -134,6 +139,7 @@ func resourceAwsEcsCapacityProviderCreate(d *schema.ResourceData, meta interface
 func resourceAwsEcsCapacityProviderRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	input := &ecs.DescribeCapacityProvidersInput{
 		CapacityProviders: []*string{aws.String(d.Id())},
@@ -169,10 +175,17 @@ func resourceAwsEcsCapacityProviderRead(d *schema.ResourceData, meta interface{}
 	d.Set("arn", provider.CapacityProviderArn)
 	d.Set("name", provider.Name)
 
-	if err := d.Set("tags", keyvaluetags.EcsKeyValueTags(provider.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.EcsKeyValueTags(provider.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	if err := d.Set("auto_scaling_group_provider", flattenAutoScalingGroupProvider(provider.AutoScalingGroupProvider)); err != nil {
 		return fmt.Errorf("error setting autoscaling group provider: %s", err)
 	}
@@ -183,8 +196,8 @@ func resourceAwsEcsCapacityProviderRead(d *schema.ResourceData, meta interface{}
 func resourceAwsEcsCapacityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.EcsUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating ECS Cluster (%s) tags: %s", d.Id(), err)
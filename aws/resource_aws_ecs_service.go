@@ -32,6 +32,8 @@ func resourceAwsEcsService() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -352,7 +354,8 @@ func resourceAwsEcsService() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 
 			"wait_for_steady_state": {
 				Type:     schema.TypeBool,
@@ -385,6 +388,8 @@ func resourceAwsEcsServiceImport(d *schema.ResourceData, meta interface{}) ([]*s
 
 func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	deploymentMinimumHealthyPercent := d.Get("deployment_minimum_healthy_percent").(int)
 	schedulingStrategy := d.Get("scheduling_strategy").(string)
@@ -395,7 +400,7 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 		DeploymentController: deploymentController,
 		SchedulingStrategy:   aws.String(schedulingStrategy),
 		ServiceName:          aws.String(d.Get("name").(string)),
-		Tags:                 keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().EcsTags(),
+		Tags:                 tags.IgnoreAws().EcsTags(),
 		TaskDefinition:       aws.String(d.Get("task_definition").(string)),
 		EnableECSManagedTags: aws.Bool(d.Get("enable_ecs_managed_tags").(bool)),
 	}
@@ -472,28 +477,7 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 		input.PlacementConstraints = pc
 	}
 
-	serviceRegistries := d.Get("service_registries").([]interface{})
-	if len(serviceRegistries) > 0 {
-		srs := make([]*ecs.ServiceRegistry, 0, len(serviceRegistries))
-		for _, v := range serviceRegistries {
-			raw := v.(map[string]interface{})
-			sr := &ecs.ServiceRegistry{
-				RegistryArn: aws.String(raw["registry_arn"].(string)),
-			}
-			if port, ok := raw["port"].(int); ok && port != 0 {
-				sr.Port = aws.Int64(int64(port))
-			}
-			if raw, ok := raw["container_port"].(int); ok && raw != 0 {
-				sr.ContainerPort = aws.Int64(int64(raw))
-			}
-			if raw, ok := raw["container_name"].(string); ok && raw != "" {
-				sr.ContainerName = aws.String(raw)
-			}
-
-			srs = append(srs, sr)
-		}
-		input.ServiceRegistries = srs
-	}
+	input.ServiceRegistries = expandEcsServiceRegistries(d.Get("service_registries").([]interface{}))
 
 	log.Printf("[DEBUG] Creating ECS service: %s", input)
 
@@ -546,6 +530,7 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	log.Printf("[DEBUG] Reading ECS service %s", d.Id())
 	input := ecs.DescribeServicesInput{
@@ -690,10 +675,17 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting service_registries for (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", keyvaluetags.EcsKeyValueTags(service.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.EcsKeyValueTags(service.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -914,6 +906,33 @@ func flattenPlacementStrategy(pss []*ecs.PlacementStrategy) []interface{} {
 	return results
 }
 
+func expandEcsServiceRegistries(l []interface{}) []*ecs.ServiceRegistry {
+	if len(l) == 0 {
+		return nil
+	}
+
+	srs := make([]*ecs.ServiceRegistry, 0, len(l))
+	for _, v := range l {
+		raw := v.(map[string]interface{})
+		sr := &ecs.ServiceRegistry{
+			RegistryArn: aws.String(raw["registry_arn"].(string)),
+		}
+		if port, ok := raw["port"].(int); ok && port != 0 {
+			sr.Port = aws.Int64(int64(port))
+		}
+		if raw, ok := raw["container_port"].(int); ok && raw != 0 {
+			sr.ContainerPort = aws.Int64(int64(raw))
+		}
+		if raw, ok := raw["container_name"].(string); ok && raw != "" {
+			sr.ContainerName = aws.String(raw)
+		}
+
+		srs = append(srs, sr)
+	}
+
+	return srs
+}
+
 func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface{} {
 	if len(srs) == 0 {
 		return nil
@@ -1060,8 +1079,8 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.EcsUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating ECS Service (%s) tags: %s", d.Id(), err)
@@ -0,0 +1,309 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfecs "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSEcsTaskSet_basic(t *testing.T) {
+	var taskSet ecs.TaskSet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsTaskSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsTaskSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsTaskSetExists(resourceName, &taskSet),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ecs", regexp.MustCompile(fmt.Sprintf("task-set/%[1]s/%[1]s/ecs-svc/.+", rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster", "aws_ecs_cluster.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service", "aws_ecs_service.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "task_definition", "aws_ecs_task_definition.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "launch_type", ecs.LaunchTypeEc2),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scale.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.unit", ecs.ScaleUnitPercent),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "0"),
+					resource.TestCheckResourceAttr(resourceName, "service_registries.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "stability_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_set_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete", "wait_until_stable", "wait_until_stable_timeout"},
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsTaskSet_disappears(t *testing.T) {
+	var taskSet ecs.TaskSet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsTaskSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsTaskSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsTaskSetExists(resourceName, &taskSet),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEcsTaskSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsTaskSet_Scale(t *testing.T) {
+	var taskSet ecs.TaskSet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsTaskSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsTaskSetConfigScale(rName, 0.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "scale.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.unit", ecs.ScaleUnitPercent),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete", "wait_until_stable", "wait_until_stable_timeout"},
+			},
+			{
+				Config: testAccAWSEcsTaskSetConfigScale(rName, 100.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "scale.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.unit", ecs.ScaleUnitPercent),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsTaskSet_Tags(t *testing.T) {
+	var taskSet ecs.TaskSet
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_ecs_task_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsTaskSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsTaskSetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete", "wait_until_stable", "wait_until_stable_timeout"},
+			},
+			{
+				Config: testAccAWSEcsTaskSetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEcsTaskSetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsTaskSetExists(resourceName, &taskSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcsTaskSetExists(resourceName string, v *ecs.TaskSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECS Task Set ID is set")
+		}
+
+		taskSetID, service, cluster, err := tfecs.TaskSetParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+
+		output, err := finder.TaskSetByTaskSetIDServiceAndCluster(conn, taskSetID, service, cluster)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEcsTaskSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecs_task_set" {
+			continue
+		}
+
+		taskSetID, service, cluster, err := tfecs.TaskSetParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.TaskSetByTaskSetIDServiceAndCluster(conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ECS Task Set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEcsTaskSetConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name          = %[1]q
+  cluster       = aws_ecs_cluster.test.id
+  desired_count = 1
+
+  deployment_controller {
+    type = "EXTERNAL"
+  }
+}
+`, rName)
+}
+
+func testAccAWSEcsTaskSetConfig(rName string) string {
+	return composeConfig(testAccAWSEcsTaskSetConfigBase(rName), `
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+}
+`)
+}
+
+func testAccAWSEcsTaskSetConfigScale(rName string, value float64) string {
+	return composeConfig(testAccAWSEcsTaskSetConfigBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+
+  scale {
+    unit  = "PERCENT"
+    value = %[1]f
+  }
+}
+`, value))
+}
+
+func testAccAWSEcsTaskSetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSEcsTaskSetConfigBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSEcsTaskSetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSEcsTaskSetConfigBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_set" "test" {
+  service         = aws_ecs_service.test.id
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
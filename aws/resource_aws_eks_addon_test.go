@@ -0,0 +1,355 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfeks "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/eks/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSEksAddon_basic(t *testing.T) {
+	var addon eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	clusterResourceName := "aws_eks_cluster.test"
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigAddonName(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "addon_name", addonName),
+					resource.TestCheckResourceAttrSet(resourceName, "addon_version"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "eks", regexp.MustCompile(fmt.Sprintf("addon/%s/%s/.+$", rName, addonName))),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", clusterResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "status", eks.AddonStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEksAddon_disappears(t *testing.T) {
+	var addon eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigAddonName(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEksAddon(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEksAddon_disappears_Cluster(t *testing.T) {
+	var addon eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_addon.test"
+	clusterResourceName := "aws_eks_cluster.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigAddonName(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEksCluster(), clusterResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEksAddon_ServiceAccountRoleArn(t *testing.T) {
+	var addon eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_addon.test"
+	serviceRoleResourceName := "aws_iam_role.test-service-role"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigServiceAccountRoleArn(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon),
+					resource.TestCheckResourceAttrPair(resourceName, "service_account_role_arn", serviceRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEksAddon_ResolveConflicts(t *testing.T) {
+	var addon eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigResolveConflicts(rName, addonName, eks.ResolveConflictsNone),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "resolve_conflicts", eks.ResolveConflictsNone),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resolve_conflicts"},
+			},
+			{
+				Config: testAccAWSEksAddonConfigResolveConflicts(rName, addonName, eks.ResolveConflictsOverwrite),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "resolve_conflicts", eks.ResolveConflictsOverwrite),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEksAddon_Tags(t *testing.T) {
+	var addon1, addon2, addon3 eks.Addon
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEks(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksAddonConfigTags1(rName, addonName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEksAddonConfigTags2(rName, addonName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEksAddonConfigTags1(rName, addonName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksAddonExists(resourceName, &addon3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEksAddonExists(resourceName string, addon *eks.Addon) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no EKS Add-On ID is set")
+		}
+
+		clusterName, addonName, err := tfeks.AddonParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).eksconn
+
+		output, err := finder.AddonByClusterNameAndAddonName(conn, clusterName, addonName)
+
+		if err != nil {
+			return err
+		}
+
+		*addon = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEksAddonDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).eksconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_eks_addon" {
+			continue
+		}
+
+		clusterName, addonName, err := tfeks.AddonParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.AddonByClusterNameAndAddonName(conn, clusterName, addonName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EKS Add-On %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSEksAddonConfigBase(rName string) string {
+	return composeConfig(testAccAWSEksClusterConfig_Base(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+}
+`, rName))
+}
+
+func testAccAWSEksAddonConfigAddonName(rName, addonName string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  addon_name   = %[1]q
+}
+`, addonName))
+}
+
+func testAccAWSEksAddonConfigServiceAccountRoleArn(rName, addonName string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test-service-role" {
+  name = "%[2]s-service"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "eks.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_eks_addon" "test" {
+  cluster_name             = aws_eks_cluster.test.name
+  addon_name               = %[1]q
+  service_account_role_arn = aws_iam_role.test-service-role.arn
+}
+`, addonName, rName))
+}
+
+func testAccAWSEksAddonConfigResolveConflicts(rName, addonName, resolveConflicts string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name      = aws_eks_cluster.test.name
+  addon_name        = %[1]q
+  resolve_conflicts = %[2]q
+}
+`, addonName, resolveConflicts))
+}
+
+func testAccAWSEksAddonConfigTags1(rName, addonName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  addon_name   = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, addonName, tagKey1, tagValue1))
+}
+
+func testAccAWSEksAddonConfigTags2(rName, addonName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSEksAddonConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  addon_name   = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, addonName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -239,7 +239,8 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -298,12 +299,14 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 				}
 				return diff.ForceNew("node_type")
 			},
+			SetTagsDiff,
 		),
 	}
 }
 
 func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	req := &elasticache.CreateCacheClusterInput{}
 
@@ -314,7 +317,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 		securityIdSet := d.Get("security_group_ids").(*schema.Set)
 		securityNames := expandStringList(securityNameSet.List())
 		securityIds := expandStringList(securityIdSet.List())
-		tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ElasticacheTags()
+		tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{}))).IgnoreAws().ElasticacheTags()
 
 		req.CacheSecurityGroupNames = securityNames
 		req.SecurityGroupIds = securityIds
@@ -411,6 +414,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	req := &elasticache.DescribeCacheClustersInput{
 		CacheClusterId:    aws.String(d.Id()),
@@ -488,9 +492,16 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("error listing tags for Elasticache Cluster (%s): %s", arn, err)
 		}
 
-		if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+		//lintignore:AWSR002
+		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 			return fmt.Errorf("error setting tags: %s", err)
 		}
+
+		if err := d.Set("tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags_all: %s", err)
+		}
 	}
 
 	return nil
@@ -499,8 +510,8 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 func resourceAwsElasticacheClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.ElasticacheUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating Elasticache Cluster (%s) tags: %s", d.Get("arn").(string), err)
@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+const (
+	elasticacheEmptyDescription = " "
+)
+
+func resourceAwsElasticacheGlobalReplicationGroup() *schema.Resource {
+	//lintignore:R011
+	return &schema.Resource{
+		Create: resourceAwsElasticacheGlobalReplicationGroupCreate,
+		Read:   resourceAwsElasticacheGlobalReplicationGroupRead,
+		Update: resourceAwsElasticacheGlobalReplicationGroupUpdate,
+		Delete: resourceAwsElasticacheGlobalReplicationGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actual_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"at_rest_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"auth_token_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_replication_group_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					// The API returns a single space when the description is unset
+					return (old == elasticacheEmptyDescription && new == "") || old == new
+				},
+				StateFunc: func(v interface{}) string {
+					s := v.(string)
+					if s == "" {
+						return elasticacheEmptyDescription
+					}
+					return s
+				},
+			},
+			"global_replication_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_replication_group_id_suffix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"primary_replication_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transit_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.GlobalReplicationGroupDefaultCreatedTimeout),
+			Update: schema.DefaultTimeout(waiter.GlobalReplicationGroupDefaultUpdatedTimeout),
+			Delete: schema.DefaultTimeout(waiter.GlobalReplicationGroupDefaultDeletedTimeout),
+		},
+	}
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	input := &elasticache.CreateGlobalReplicationGroupInput{
+		GlobalReplicationGroupIdSuffix: aws.String(d.Get("global_replication_group_id_suffix").(string)),
+		PrimaryReplicationGroupId:      aws.String(d.Get("primary_replication_group_id").(string)),
+	}
+
+	if v, ok := d.GetOk("global_replication_group_description"); ok {
+		input.GlobalReplicationGroupDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating ElastiCache Global Replication Group: %s", input)
+	output, err := conn.CreateGlobalReplicationGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ElastiCache Global Replication Group: %w", err)
+	}
+
+	if output == nil || output.GlobalReplicationGroup == nil {
+		return fmt.Errorf("error creating ElastiCache Global Replication Group: empty output")
+	}
+
+	d.SetId(aws.StringValue(output.GlobalReplicationGroup.GlobalReplicationGroupId))
+
+	if _, err := waiter.GlobalReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsElasticacheGlobalReplicationGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(globalReplicationGroup.Status); !d.IsNewResource() && (status == waiter.GlobalReplicationGroupStatusDeleting || status == waiter.GlobalReplicationGroupStatusDeleted) {
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) in deleted state (%s), removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("actual_engine_version", globalReplicationGroup.EngineVersion)
+	d.Set("arn", globalReplicationGroup.ARN)
+	d.Set("at_rest_encryption_enabled", globalReplicationGroup.AtRestEncryptionEnabled)
+	d.Set("auth_token_enabled", globalReplicationGroup.AuthTokenEnabled)
+	d.Set("cache_node_type", globalReplicationGroup.CacheNodeType)
+	d.Set("cluster_enabled", globalReplicationGroup.ClusterEnabled)
+	d.Set("engine", globalReplicationGroup.Engine)
+	d.Set("global_replication_group_description", globalReplicationGroup.GlobalReplicationGroupDescription)
+	d.Set("global_replication_group_id", globalReplicationGroup.GlobalReplicationGroupId)
+	d.Set("transit_encryption_enabled", globalReplicationGroup.TransitEncryptionEnabled)
+
+	d.Set("primary_replication_group_id", flattenElasticacheGlobalReplicationGroupPrimaryGroupID(globalReplicationGroup.Members))
+
+	return nil
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	if d.HasChange("global_replication_group_description") {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:                  aws.Bool(true),
+			GlobalReplicationGroupDescription: aws.String(d.Get("global_replication_group_description").(string)),
+			GlobalReplicationGroupId:          aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating ElastiCache Global Replication Group: %s", input)
+		_, err := conn.ModifyGlobalReplicationGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.GlobalReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsElasticacheGlobalReplicationGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	err := deleteElasticacheGlobalReplicationGroup(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return fmt.Errorf("error deleting ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func deleteElasticacheGlobalReplicationGroup(conn *elasticache.ElastiCache, id string, timeout time.Duration) error {
+	input := &elasticache.DeleteGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:      aws.String(id),
+		RetainPrimaryReplicationGroup: aws.Bool(true),
+	}
+
+	// Using Update timeout because the Global Replication Group could be in the middle of an update operation,
+	// e.g. a secondary Replication Group being disassociated.
+	err := resource.Retry(waiter.GlobalReplicationGroupDefaultUpdatedTimeout, func() *resource.RetryError {
+		_, err := conn.DeleteGlobalReplicationGroup(input)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteGlobalReplicationGroup(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waiter.GlobalReplicationGroupDeleted(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for deletion: %w", err)
+	}
+
+	return nil
+}
+
+func flattenElasticacheGlobalReplicationGroupPrimaryGroupID(members []*elasticache.GlobalReplicationGroupMember) string {
+	for _, member := range members {
+		if aws.StringValue(member.Role) == waiter.GlobalReplicationGroupMemberRolePrimary {
+			return aws.StringValue(member.ReplicationGroupId)
+		}
+	}
+	return ""
+}
@@ -10,11 +10,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsElasticacheReplicationGroup() *schema.Resource {
@@ -28,6 +32,8 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"apply_immediately": {
 				Type:     schema.TypeBool,
@@ -103,6 +109,12 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"global_replication_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
 			"maintenance_window": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -120,6 +132,11 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"multi_az_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"node_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -228,7 +245,8 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"transit_encryption_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -240,6 +258,12 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			"user_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 		SchemaVersion: 1,
 
@@ -260,18 +284,26 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 
 func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
-	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ElasticacheTags()
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{}))).IgnoreAws().ElasticacheTags()
 	params := &elasticache.CreateReplicationGroupInput{
 		ReplicationGroupId:          aws.String(d.Get("replication_group_id").(string)),
 		ReplicationGroupDescription: aws.String(d.Get("replication_group_description").(string)),
 		AutomaticFailoverEnabled:    aws.Bool(d.Get("automatic_failover_enabled").(bool)),
 		AutoMinorVersionUpgrade:     aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
-		CacheNodeType:               aws.String(d.Get("node_type").(string)),
-		Engine:                      aws.String(d.Get("engine").(string)),
 		Tags:                        tags,
 	}
 
+	if v, ok := d.GetOk("global_replication_group_id"); ok {
+		// Secondary Replication Groups inherit their engine and node type
+		// from the Global Replication Group's primary.
+		params.GlobalReplicationGroupId = aws.String(v.(string))
+	} else {
+		params.CacheNodeType = aws.String(d.Get("node_type").(string))
+		params.Engine = aws.String(d.Get("engine").(string))
+	}
+
 	if v, ok := d.GetOk("engine_version"); ok {
 		params.EngineVersion = aws.String(v.(string))
 	}
@@ -340,6 +372,14 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 		params.AuthToken = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("multi_az_enabled"); ok {
+		params.MultiAZEnabled = aws.Bool(v.(bool))
+	}
+
+	if v := d.Get("user_group_ids").(*schema.Set); v.Len() > 0 {
+		params.UserGroupIds = expandStringSet(v)
+	}
+
 	clusterMode, clusterModeOk := d.GetOk("cluster_mode")
 	cacheClusters, cacheClustersOk := d.GetOk("number_cache_clusters")
 
@@ -393,6 +433,7 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	req := &elasticache.DescribeReplicationGroupsInput{
 		ReplicationGroupId: aws.String(d.Id()),
@@ -439,7 +480,16 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 		}
 	}
 
+	if rgp.GlobalReplicationGroupInfo != nil {
+		d.Set("global_replication_group_id", rgp.GlobalReplicationGroupInfo.GlobalReplicationGroupId)
+	}
+
 	d.Set("kms_key_id", rgp.KmsKeyId)
+	d.Set("multi_az_enabled", aws.StringValue(rgp.MultiAZ) == elasticache.MultiAZStatusEnabled)
+
+	if err := d.Set("user_group_ids", flattenStringSet(rgp.UserGroupIds)); err != nil {
+		return fmt.Errorf("error setting user_group_ids: %w", err)
+	}
 
 	d.Set("replication_group_description", rgp.Description)
 	d.Set("number_cache_clusters", len(rgp.MemberClusters))
@@ -516,9 +566,16 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 			return fmt.Errorf("error listing tags for resource (%s): %w", arn, err)
 		}
 
-		if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+		//lintignore:AWSR002
+		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 			return fmt.Errorf("error setting tags: %w", err)
 		}
+
+		if err := d.Set("tags_all", tags.Map()); err != nil {
+			return fmt.Errorf("error setting tags_all: %w", err)
+		}
 	}
 
 	return nil
@@ -742,6 +799,27 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 		requestUpdate = true
 	}
 
+	if d.HasChange("multi_az_enabled") {
+		params.MultiAZEnabled = aws.Bool(d.Get("multi_az_enabled").(bool))
+		requestUpdate = true
+	}
+
+	if d.HasChange("user_group_ids") {
+		o, n := d.GetChange("user_group_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			params.UserGroupIdsToAdd = expandStringSet(add)
+			requestUpdate = true
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			params.UserGroupIdsToRemove = expandStringSet(del)
+			requestUpdate = true
+		}
+	}
+
 	if d.HasChange("security_group_ids") {
 		if attr := d.Get("security_group_ids").(*schema.Set); attr.Len() > 0 {
 			params.SecurityGroupIds = expandStringSet(attr)
@@ -809,7 +887,7 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		clusters := d.Get("member_clusters").(*schema.Set).List()
 
 		for _, cluster := range clusters {
@@ -822,7 +900,7 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 				Resource:  fmt.Sprintf("cluster:%s", cluster),
 			}.String()
 
-			o, n := d.GetChange("tags")
+			o, n := d.GetChange("tags_all")
 			if err := keyvaluetags.ElasticacheUpdateTags(conn, arn, o, n); err != nil {
 				return fmt.Errorf("error updating tags: %w", err)
 			}
@@ -835,6 +913,13 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 func resourceAwsElasticacheReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
+	if globalReplicationGroupID, ok := d.GetOk("global_replication_group_id"); ok {
+		err := disassociateElasticacheReplicationGroup(conn, globalReplicationGroupID.(string), d.Id(), meta.(*AWSClient).region)
+		if err != nil {
+			return fmt.Errorf("error disassociating Elasticache Replication Group (%s) from Global Replication Group (%s): %w", d.Id(), globalReplicationGroupID, err)
+		}
+	}
+
 	err := deleteElasticacheReplicationGroup(d.Id(), conn)
 	if err != nil {
 		return fmt.Errorf("error deleting Elasticache Replication Group (%s): %w", d.Id(), err)
@@ -891,6 +976,49 @@ func cacheReplicationGroupStateRefreshFunc(conn *elasticache.ElastiCache, replic
 	}
 }
 
+// disassociateElasticacheReplicationGroup removes a secondary Replication Group from a Global Replication Group.
+// Primary Replication Groups are left associated; they are detached when the Global Replication Group is deleted.
+func disassociateElasticacheReplicationGroup(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID, region string) error {
+	member, err := finder.GlobalReplicationGroupMemberByID(conn, globalReplicationGroupID, replicationGroupID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(member.Role) != waiter.GlobalReplicationGroupMemberRoleSecondary {
+		return nil
+	}
+
+	input := &elasticache.DisassociateGlobalReplicationGroupInput{
+		GlobalReplicationGroupId: aws.String(globalReplicationGroupID),
+		ReplicationGroupId:       aws.String(replicationGroupID),
+		ReplicationGroupRegion:   aws.String(region),
+	}
+
+	log.Printf("[DEBUG] Disassociating Elasticache Replication Group: %s", input)
+	_, err = conn.DisassociateGlobalReplicationGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = waiter.GlobalReplicationGroupMemberDetached(conn, globalReplicationGroupID, replicationGroupID)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for disassociation: %w", err)
+	}
+
+	return nil
+}
+
 func deleteElasticacheReplicationGroup(replicationGroupID string, conn *elasticache.ElastiCache) error {
 	input := &elasticache.DeleteReplicationGroupInput{
 		ReplicationGroupId: aws.String(replicationGroupID),
@@ -0,0 +1,358 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const fmsPolicyResourceTypeList = "ResourceTypeList"
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"delete_all_policy_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"exclude_map": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"orgunit": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"include_map": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"orgunit": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"resource_tags": tagsSchema(),
+
+			"resource_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"resource_type_list"},
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^([\p{L}\p{Z}\p{N}_.:/=+\-@]*)$`), "must match a supported resource type, such as AWS::EC2::VPC, see also: https://docs.aws.amazon.com/fms/2018-01-01/APIReference/API_Policy.html"),
+			},
+
+			"resource_type_list": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Set:           schema.HashString,
+				ConflictsWith: []string{"resource_type"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([\p{L}\p{Z}\p{N}_.:/=+\-@]*)$`), "must match a supported resource type, such as AWS::EC2::VPC, see also: https://docs.aws.amazon.com/fms/2018-01-01/APIReference/API_Policy.html"),
+				},
+			},
+
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(fms.SecurityServiceType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.PutPolicyInput{
+		Policy: resourceAwsFmsPolicyExpandPolicy(d),
+	}
+
+	output, err := conn.PutPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FMS Policy: %w", err)
+	}
+
+	if output == nil || output.Policy == nil {
+		return fmt.Errorf("error creating FMS Policy: empty output")
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := conn.GetPolicy(&fms.GetPolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Policy (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.Policy == nil {
+		return fmt.Errorf("error reading FMS Policy (%s): empty output", d.Id())
+	}
+
+	policy := output.Policy
+
+	d.Set("arn", output.PolicyArn)
+	d.Set("name", policy.PolicyName)
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+
+	if err := d.Set("exclude_map", flattenFMSPolicyMap(policy.ExcludeMap)); err != nil {
+		return fmt.Errorf("error setting exclude_map: %w", err)
+	}
+
+	if err := d.Set("include_map", flattenFMSPolicyMap(policy.IncludeMap)); err != nil {
+		return fmt.Errorf("error setting include_map: %w", err)
+	}
+
+	if err := d.Set("resource_tags", keyvaluetags.FmsKeyValueTags(policy.ResourceTags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting resource_tags: %w", err)
+	}
+
+	if aws.StringValue(policy.ResourceType) == fmsPolicyResourceTypeList {
+		d.Set("resource_type", nil)
+	} else {
+		d.Set("resource_type", policy.ResourceType)
+	}
+
+	if err := d.Set("resource_type_list", aws.StringValueSlice(policy.ResourceTypeList)); err != nil {
+		return fmt.Errorf("error setting resource_type_list: %w", err)
+	}
+
+	if err := d.Set("security_service_policy_data", flattenFMSSecurityServicePolicyData(policy.SecurityServicePolicyData)); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	if d.HasChangesExcept("delete_all_policy_resources") {
+		input := &fms.PutPolicyInput{
+			Policy: resourceAwsFmsPolicyExpandPolicy(d),
+		}
+
+		_, err := conn.PutPolicy(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating FMS Policy (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	log.Printf("[DEBUG] Deleting FMS Policy: %s", d.Id())
+	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
+		PolicyId:                 aws.String(d.Id()),
+		DeleteAllPolicyResources: aws.Bool(d.Get("delete_all_policy_resources").(bool)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		ExcludeMap:          expandFMSPolicyMap(d.Get("exclude_map").([]interface{})),
+		ExcludeResourceTags: aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		IncludeMap:          expandFMSPolicyMap(d.Get("include_map").([]interface{})),
+		PolicyName:          aws.String(d.Get("name").(string)),
+		RemediationEnabled:  aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:        keyvaluetags.New(d.Get("resource_tags").(map[string]interface{})).IgnoreAws().FmsTags(),
+		ResourceType:        aws.String(fmsPolicyResourceTypeList),
+	}
+
+	if d.Id() != "" {
+		policy.PolicyId = aws.String(d.Id())
+		policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		policy.ResourceType = aws.String(v.(string))
+	} else if v, ok := d.GetOk("resource_type_list"); ok && v.(*schema.Set).Len() > 0 {
+		policy.ResourceTypeList = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("security_service_policy_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		policy.SecurityServicePolicyData = &fms.SecurityServicePolicyData{
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["managed_service_data"].(string); ok && v != "" {
+			policy.SecurityServicePolicyData.ManagedServiceData = aws.String(v)
+		}
+	}
+
+	return policy
+}
+
+func expandFMSPolicyMap(tfList []interface{}) map[string][]*string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := map[string][]*string{}
+
+	if v, ok := tfMap["account"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject[fms.CustomerPolicyScopeIdTypeAccount] = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["orgunit"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject[fms.CustomerPolicyScopeIdTypeOrgUnit] = expandStringSet(v)
+	}
+
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	return apiObject
+}
+
+func flattenFMSPolicyMap(apiObject map[string][]*string) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v, ok := apiObject[fms.CustomerPolicyScopeIdTypeAccount]; ok {
+		tfMap["account"] = aws.StringValueSlice(v)
+	}
+
+	if v, ok := apiObject[fms.CustomerPolicyScopeIdTypeOrgUnit]; ok {
+		tfMap["orgunit"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFMSSecurityServicePolicyData(apiObject *fms.SecurityServicePolicyData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type": aws.StringValue(apiObject.Type),
+	}
+
+	if v := apiObject.ManagedServiceData; v != nil {
+		tfMap["managed_service_data"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
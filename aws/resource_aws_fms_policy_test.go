@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSFmsPolicy_basic(t *testing.T) {
+	resourceName := "aws_fms_policy.test"
+	fmsPolicyName := acctest.RandomWithPrefix("tf-acc-test")
+	wafRuleGroupName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFmsAdmin(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig(fmsPolicyName, wafRuleGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fms", regexp.MustCompile(`policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", fmsPolicyName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_type_list.*", "AWS::ElasticLoadBalancingV2::LoadBalancer"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "WAF"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources"},
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_includeMap(t *testing.T) {
+	resourceName := "aws_fms_policy.test"
+	fmsPolicyName := acctest.RandomWithPrefix("tf-acc-test")
+	wafRuleGroupName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFmsAdmin(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig_include(fmsPolicyName, wafRuleGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "include_map.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "include_map.0.account.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_map.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources"},
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_resourceTags(t *testing.T) {
+	resourceName := "aws_fms_policy.test"
+	fmsPolicyName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckFmsAdmin(t)
+			testAccOrganizationsAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig_resourceTags(fmsPolicyName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::EC2::SecurityGroup"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccFmsPolicyConfig_resourceTags(fmsPolicyName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsFmsPolicyDestroy(s *terraform.State) error {
+	conn := testAccProviderFmsAdmin.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_policy" {
+			continue
+		}
+
+		output, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.Policy != nil {
+			return fmt.Errorf("FMS Policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsFmsPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Policy ID is set")
+		}
+
+		conn := testAccProviderFmsAdmin.Meta().(*AWSClient).fmsconn
+
+		_, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccFmsPolicyConfigBase() string {
+	return composeConfig(
+		testAccFmsAdminRegionProviderConfig(),
+		`
+data "aws_partition" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["fms.${data.aws_partition.current.dns_suffix}"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_fms_admin_account" "test" {
+  account_id = aws_organizations_organization.test.master_account_id
+}
+`)
+}
+
+func testAccFmsPolicyConfig(name, group string) string {
+	return composeConfig(
+		testAccFmsPolicyConfigBase(),
+		fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type_list    = ["AWS::ElasticLoadBalancingV2::LoadBalancer"]
+
+  exclude_map {
+    account = [aws_organizations_organization.test.accounts[0].id]
+  }
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [{\"id\":\"${aws_wafregional_rule_group.test.id}\", \"overrideAction\" : {\"type\": \"COUNT\"}}],\"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+
+resource "aws_wafregional_rule_group" "test" {
+  metric_name = "MyTest"
+  name        = %[2]q
+}
+`, name, group))
+}
+
+func testAccFmsPolicyConfig_include(name, group string) string {
+	return composeConfig(
+		testAccFmsPolicyConfigBase(),
+		fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type_list    = ["AWS::ElasticLoadBalancingV2::LoadBalancer"]
+
+  include_map {
+    account = [aws_organizations_organization.test.accounts[0].id]
+  }
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [{\"id\":\"${aws_wafregional_rule_group.test.id}\", \"overrideAction\" : {\"type\": \"COUNT\"}}],\"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+
+resource "aws_wafregional_rule_group" "test" {
+  metric_name = "MyTest"
+  name        = %[2]q
+}
+`, name, group))
+}
+
+func testAccFmsPolicyConfig_resourceTags(name, tagKey, tagValue string) string {
+	return composeConfig(
+		testAccFmsPolicyConfigBase(),
+		fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::EC2::SecurityGroup"
+
+  resource_tags = {
+    %[2]q = %[3]q
+  }
+
+  security_service_policy_data {
+    type                 = "SECURITY_GROUPS_USAGE_AUDIT"
+    managed_service_data = "{\"type\": \"SECURITY_GROUPS_USAGE_AUDIT\", \"deleteUnusedSecurityGroups\": false, \"coalesceRedundantSecurityGroups\": false}"
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, name, tagKey, tagValue))
+}
@@ -28,6 +28,8 @@ func resourceAwsGlueDevEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arguments": {
 				Type:     schema.TypeMap,
@@ -113,7 +115,8 @@ func resourceAwsGlueDevEndpoint() *schema.Resource {
 				ForceNew:     true,
 				RequiredWith: []string{"security_group_ids"},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"private_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -159,12 +162,15 @@ func resourceAwsGlueDevEndpoint() *schema.Resource {
 
 func resourceAwsGlueDevEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).glueconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
 	name := d.Get("name").(string)
 
 	input := &glue.CreateDevEndpointInput{
 		EndpointName: aws.String(name),
 		RoleArn:      aws.String(d.Get("role_arn").(string)),
-		Tags:         keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().GlueTags(),
+		Tags:         tags.IgnoreAws().GlueTags(),
 	}
 
 	if v, ok := d.GetOk("arguments"); ok {
@@ -258,6 +264,7 @@ func resourceAwsGlueDevEndpointCreate(d *schema.ResourceData, meta interface{})
 func resourceAwsGlueDevEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).glueconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	request := &glue.GetDevEndpointInput{
 		EndpointName: aws.String(d.Id()),
@@ -378,10 +385,17 @@ func resourceAwsGlueDevEndpointRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error listing tags for Glue Dev Endpoint (%s): %s", endpointARN, err)
 	}
 
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	if err := d.Set("yarn_endpoint_address", endpoint.YarnEndpointAddress); err != nil {
 		return fmt.Errorf("error setting yarn_endpoint_address for Glue Dev Endpoint (%s): %s", d.Id(), err)
 	}
@@ -488,8 +502,8 @@ func resourceAwsDevEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.GlueUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -32,6 +32,8 @@ func resourceAwsGuardDutyFilter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -53,7 +55,8 @@ func resourceAwsGuardDutyFilter() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"finding_criteria": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -126,6 +129,8 @@ func resourceAwsGuardDutyFilter() *schema.Resource {
 
 func resourceAwsGuardDutyFilterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).guarddutyconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	input := guardduty.CreateFilterInput{
 		Action:      aws.String(d.Get("action").(string)),
@@ -141,11 +146,8 @@ func resourceAwsGuardDutyFilterCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		tags := v.(map[string]interface{})
-		if len(tags) > 0 {
-			input.Tags = keyvaluetags.New(tags).GuarddutyTags()
-		}
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().GuarddutyTags()
 	}
 
 	log.Printf("[DEBUG] Creating GuardDuty Filter: %s", input)
@@ -213,7 +215,12 @@ func resourceAwsGuardDutyFilterRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("detector_id", detectorID)
 	d.Set("rank", filter.Rank)
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
-	d.Set("tags", keyvaluetags.GuarddutyKeyValueTags(filter.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := keyvaluetags.GuarddutyKeyValueTags(filter.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map())
+	d.Set("tags_all", tags.Map())
 	d.SetId(guardDutyFilterCreateID(detectorID, name))
 
 	return nil
@@ -245,8 +252,8 @@ func resourceAwsGuardDutyFilterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.GuarddutyUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating GuardDuty Filter (%s) tags: %s", d.Get("arn").(string), err)
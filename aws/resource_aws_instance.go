@@ -22,6 +22,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
@@ -46,6 +47,8 @@ func resourceAwsInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:     schema.TypeString,
@@ -337,7 +340,8 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 
 			"volume_tags": tagsSchemaComputed(),
 
@@ -634,13 +638,15 @@ func throughputDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 
 func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	instanceOpts, err := buildAwsInstanceOpts(d, meta)
 	if err != nil {
 		return err
 	}
 
-	tagSpecifications := ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeInstance)
+	tagSpecifications := ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInstance)
 	tagSpecifications = append(tagSpecifications, ec2TagSpecificationsFromMap(d.Get("volume_tags").(map[string]interface{}), ec2.ResourceTypeVolume)...)
 
 	// Build the creation struct
@@ -727,28 +733,14 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	// Wait for the instance to become running so we can get some attributes
 	// that aren't available until later.
-	log.Printf(
-		"[DEBUG] Waiting for instance (%s) to become running",
-		aws.StringValue(instance.InstanceId))
+	log.Printf("[DEBUG] Waiting for instance (%s) to become running", d.Id())
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{ec2.InstanceStateNamePending},
-		Target:     []string{ec2.InstanceStateNameRunning},
-		Refresh:    InstanceStateRefreshFunc(conn, aws.StringValue(instance.InstanceId), []string{ec2.InstanceStateNameTerminated, ec2.InstanceStateNameShuttingDown}),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
+	instance, err = waiter.InstanceRunning(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 
-	instanceRaw, err := stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for instance (%s) to become ready: %s",
-			aws.StringValue(instance.InstanceId), err)
+		return fmt.Errorf("Error waiting for instance (%s) to become ready: %w", d.Id(), err)
 	}
 
-	instance = instanceRaw.(*ec2.Instance)
-
 	// Initialize the connection info
 	if instance.PublicIpAddress != nil {
 		d.SetConnInfo(map[string]string{
@@ -769,33 +761,25 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
-	instance, err := resourceAwsInstanceFindByID(conn, d.Id())
-	if err != nil {
-		// If the instance was not found, return nil so that we can show
-		// that the instance is gone.
-		if isAWSErr(err, "InvalidInstanceID.NotFound", "") {
-			d.SetId("")
-			return nil
-		}
+	instanceRaw, err := tfresource.RetryWhenNewResourceNotFound(waiter.PropagationTimeout, func() (interface{}, error) {
+		return finder.InstanceByID(conn, d.Id())
+	}, d.IsNewResource())
 
-		// Some other error, report it
-		return err
-	}
-
-	// If nothing was found, then return no state
-	if instance == nil {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	if instance.State != nil {
-		// If the instance is terminated, then it is gone
-		if aws.StringValue(instance.State.Name) == ec2.InstanceStateNameTerminated {
-			d.SetId("")
-			return nil
-		}
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance (%s): %w", d.Id(), err)
+	}
 
+	instance := instanceRaw.(*ec2.Instance)
+
+	if instance.State != nil {
 		d.Set("instance_state", instance.State.Name)
 	}
 
@@ -932,10 +916,17 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring", monitoringState == ec2.MonitoringStateEnabled || monitoringState == ec2.MonitoringStatePending)
 	}
 
-	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(instance.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.Ec2KeyValueTags(instance.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	volumeTags, err := readVolumeTags(conn, d.Id())
 	if err != nil {
 		return err
@@ -1033,8 +1024,8 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	if d.HasChange("tags") && !d.IsNewResource() {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") && !d.IsNewResource() {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
@@ -1305,20 +1296,8 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error starting EC2 Instance (%s): %w", d.Id(), err)
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameStopped},
-			Target:     []string{ec2.InstanceStateNameRunning},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), []string{ec2.InstanceStateNameTerminated}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to become ready: %s",
-				d.Id(), err)
+		if _, err := waiter.InstanceRunning(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for instance (%s) to become ready: %w", d.Id(), err)
 		}
 	}
 
@@ -2440,20 +2419,8 @@ func awsTerminateInstance(conn *ec2.EC2, id string, timeout time.Duration) error
 func waitForInstanceStopping(conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for instance (%s) to become stopped", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning,
-			ec2.InstanceStateNameShuttingDown, ec2.InstanceStateNameStopped, ec2.InstanceStateNameStopping},
-		Target:     []string{ec2.InstanceStateNameStopped},
-		Refresh:    InstanceStateRefreshFunc(conn, id, []string{}),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf(
-			"error waiting for instance (%s) to stop: %s", id, err)
+	if _, err := waiter.InstanceStopped(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for instance (%s) to stop: %w", id, err)
 	}
 
 	return nil
@@ -2462,20 +2429,8 @@ func waitForInstanceStopping(conn *ec2.EC2, id string, timeout time.Duration) er
 func waitForInstanceDeletion(conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for instance (%s) to become terminated", id)
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning,
-			ec2.InstanceStateNameShuttingDown, ec2.InstanceStateNameStopped, ec2.InstanceStateNameStopping},
-		Target:     []string{ec2.InstanceStateNameTerminated},
-		Refresh:    InstanceStateRefreshFunc(conn, id, []string{}),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err := stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for instance (%s) to terminate: %s", id, err)
+	if _, err := waiter.InstanceTerminated(conn, id, timeout); err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to terminate: %w", id, err)
 	}
 
 	return nil
@@ -12,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsInternetGateway() *schema.Resource {
@@ -24,12 +27,15 @@ func resourceAwsInternetGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -44,12 +50,14 @@ func resourceAwsInternetGateway() *schema.Resource {
 
 func resourceAwsInternetGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	// Create the gateway
 	log.Printf("[DEBUG] Creating internet gateway")
 	var err error
 	input := &ec2.CreateInternetGatewayInput{
-		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeInternetGateway),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInternetGateway),
 	}
 	resp, err := conn.CreateInternetGateway(input)
 	if err != nil {
@@ -60,26 +68,9 @@ func resourceAwsInternetGatewayCreate(d *schema.ResourceData, meta interface{})
 	ig := *resp.InternetGateway
 	d.SetId(aws.StringValue(ig.InternetGatewayId))
 	log.Printf("[INFO] InternetGateway ID: %s", d.Id())
-	var igRaw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		igRaw, _, err = IGStateRefreshFunc(conn, d.Id())()
-		if igRaw != nil {
-			return nil
-		}
-		if err == nil {
-			return resource.RetryableError(&resource.NotFoundError{})
-		} else {
-			return resource.NonRetryableError(err)
-		}
-	})
-	if isResourceTimeoutError(err) {
-		igRaw, _, err = IGStateRefreshFunc(conn, d.Id())()
-		if igRaw == nil {
-			return fmt.Errorf("error finding Internet Gateway (%s) after creation; retry running Terraform", d.Id())
-		}
-	}
-	if err != nil {
-		return fmt.Errorf("Error refreshing internet gateway state: %s", err)
+
+	if _, err := waiter.InternetGatewayAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Internet Gateway (%s) to become available: %w", d.Id(), err)
 	}
 
 	// Attach the new gateway to the correct vpc
@@ -94,17 +85,22 @@ func resourceAwsInternetGatewayCreate(d *schema.ResourceData, meta interface{})
 func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
-	igRaw, _, err := IGStateRefreshFunc(conn, d.Id())()
-	if err != nil {
-		return err
-	}
-	if igRaw == nil {
+	igRaw, err := tfresource.RetryWhenNewResourceNotFound(waiter.PropagationTimeout, func() (interface{}, error) {
+		return finder.InternetGatewayByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Internet Gateway (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Internet Gateway (%s): %w", d.Id(), err)
+	}
+
 	ig := igRaw.(*ec2.InternetGateway)
 	if len(ig.Attachments) == 0 {
 		// Gateway exists but not attached to the VPC
@@ -113,10 +109,17 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("vpc_id", ig.Attachments[0].VpcId)
 	}
 
-	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(ig.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.Ec2KeyValueTags(ig.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	d.Set("owner_id", ig.OwnerId)
 
 	arn := arn.ARN{
@@ -147,8 +150,8 @@ func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{})
 
 	conn := meta.(*AWSClient).ec2conn
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating EC2 Internet Gateway (%s) tags: %s", d.Id(), err)
@@ -347,33 +350,6 @@ func findPublicNetworkInterfacesForVpcID(conn *ec2.EC2, vpcID string) (*ec2.Desc
 	})
 }
 
-// IGStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// an internet gateway.
-func IGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := conn.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
-			InternetGatewayIds: []*string{aws.String(id)},
-		})
-		if err != nil {
-			if isAWSErr(err, "InvalidInternetGatewayID.NotFound", "") {
-				resp = nil
-			} else {
-				log.Printf("[ERROR] Error on IGStateRefresh: %s", err)
-				return nil, "", err
-			}
-		}
-
-		if resp == nil {
-			// Sometimes AWS just has consistency issues and doesn't see
-			// our instance yet. Return an empty state.
-			return nil, "", nil
-		}
-
-		ig := resp.InternetGateways[0]
-		return ig, "available", nil
-	}
-}
-
 // IGAttachStateRefreshFunc returns a resource.StateRefreshFunc that is used
 // watch the state of an internet gateway's attachment.
 func IGAttachStateRefreshFunc(conn *ec2.EC2, id string, expected string) resource.StateRefreshFunc {
@@ -0,0 +1,728 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	tflakeformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsLakeFormationPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationPermissionsCreate,
+		Read:   resourceAwsLakeFormationPermissionsRead,
+		Delete: resourceAwsLakeFormationPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"catalog_resource": {
+				Type:     schema.TypeBool,
+				ForceNew: true,
+				Optional: true,
+				Default:  false,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+			},
+			"data_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues, // can be non-ARN, e.g. "IAM_ALLOWED_PRINCIPALS"
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							ForceNew: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							AtLeastOneOf: []string{
+								"table_with_columns.0.column_names",
+								"table_with_columns.0.wildcard",
+							},
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							ForceNew: true,
+							AtLeastOneOf: []string{
+								"table_with_columns.0.column_names",
+								"table_with_columns.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.GrantPermissionsInput{
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: expandLakeFormationPermissionsResource(d, false),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok && v.(*schema.Set).Len() > 0 {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	var output *lakeformation.GrantPermissionsOutput
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+		output, err = conn.GrantPermissions(input)
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal") {
+				return resource.RetryableError(err)
+			}
+			// Lake Formation rejects grants made while another grant is being processed
+			if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.GrantPermissions(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Lake Formation Permissions (input: %v): %w", input, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating Lake Formation Permissions: empty response")
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+
+	return resourceAwsLakeFormationPermissionsRead(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		// Table with columns permissions cannot be listed directly, so the table is listed instead
+		Resource: expandLakeFormationPermissionsResource(d, true),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	tableType, columnNames, excludedColumnNames, columnWildcard := expandLakeFormationPermissionsTableType(d)
+
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+	var err error
+
+	if d.IsNewResource() {
+		cleanPermissions, err = waiter.PermissionsReady(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)
+	} else {
+		var outputRaw interface{}
+		outputRaw, _, err = waiter.PermissionsStatus(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)()
+		cleanPermissions, _ = outputRaw.([]*lakeformation.PrincipalResourcePermissions)
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Resource Lake Formation permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Permissions (%s): %w", d.Id(), err)
+	}
+
+	if len(cleanPermissions) == 0 {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lake Formation Permissions (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Resource Lake Formation permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return setLakeFormationPermissionsData(d, cleanPermissions, tableType)
+}
+
+func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.RevokePermissionsInput{
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: expandLakeFormationPermissionsResource(d, false),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok && v.(*schema.Set).Len() > 0 {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	err := resource.Retry(waiter.PermissionsDeleteRetryTimeout, func() *resource.RetryError {
+		_, err := conn.RevokePermissions(input)
+		if err != nil {
+			if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.RevokePermissions(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "No permissions revoked") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation Permissions (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandLakeFormationPermissionsResource builds the API resource from the configured resource block.
+// When listing, table with columns resources are expanded as tables since they cannot be listed directly.
+func expandLakeFormationPermissionsResource(d *schema.ResourceData, forList bool) *lakeformation.Resource {
+	res := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("catalog_resource"); ok && v.(bool) {
+		res.Catalog = &lakeformation.CatalogResource{}
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		res.DataLocation = expandLakeFormationDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		res.Database = expandLakeFormationDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		res.Table = expandLakeFormationTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if forList {
+			res.Table = expandLakeFormationTableWithColumnsResourceAsTable(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			res.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	return res
+}
+
+// expandLakeFormationPermissionsTableType returns the details needed to filter listed table permissions.
+func expandLakeFormationPermissionsTableType(d *schema.ResourceData) (string, []*string, []*string, bool) {
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		var columnNames, excludedColumnNames []*string
+
+		if v, ok := tfMap["column_names"].(*schema.Set); ok {
+			columnNames = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok {
+			excludedColumnNames = expandStringSet(v)
+		}
+
+		columnWildcard, _ := tfMap["wildcard"].(bool)
+
+		return tflakeformation.TableTypeTableWithColumns, columnNames, excludedColumnNames, columnWildcard
+	}
+
+	if _, ok := d.GetOk("table"); ok {
+		return tflakeformation.TableTypeTable, nil, nil, false
+	}
+
+	return "", nil, nil, false
+}
+
+func setLakeFormationPermissionsData(d *schema.ResourceData, cleanPermissions []*lakeformation.PrincipalResourcePermissions, tableType string) error {
+	permissions := &schema.Set{F: schema.HashString}
+	permissionsWithGrantOption := &schema.Set{F: schema.HashString}
+
+	for _, perm := range cleanPermissions {
+		for _, v := range perm.Permissions {
+			permissions.Add(aws.StringValue(v))
+		}
+
+		for _, v := range perm.PermissionsWithGrantOption {
+			permissionsWithGrantOption.Add(aws.StringValue(v))
+		}
+	}
+
+	d.Set("principal", cleanPermissions[0].Principal.DataLakePrincipalIdentifier)
+
+	if err := d.Set("permissions", permissions); err != nil {
+		return fmt.Errorf("error setting permissions: %w", err)
+	}
+
+	if err := d.Set("permissions_with_grant_option", permissionsWithGrantOption); err != nil {
+		return fmt.Errorf("error setting permissions_with_grant_option: %w", err)
+	}
+
+	res := cleanPermissions[0].Resource
+
+	if res.Catalog != nil {
+		d.Set("catalog_resource", true)
+	}
+
+	if res.DataLocation != nil {
+		if err := d.Set("data_location", []interface{}{flattenLakeFormationDataLocationResource(res.DataLocation)}); err != nil {
+			return fmt.Errorf("error setting data_location: %w", err)
+		}
+	} else {
+		d.Set("data_location", nil)
+	}
+
+	if res.Database != nil {
+		if err := d.Set("database", []interface{}{flattenLakeFormationDatabaseResource(res.Database)}); err != nil {
+			return fmt.Errorf("error setting database: %w", err)
+		}
+	} else {
+		d.Set("database", nil)
+	}
+
+	switch tableType {
+	case tflakeformation.TableTypeTableWithColumns:
+		// Only the configured table with columns block identifies the grant, as listed
+		// permissions are split between the table and table with columns resources
+		if v, ok := d.GetOk("table_with_columns"); ok {
+			if err := d.Set("table_with_columns", v); err != nil {
+				return fmt.Errorf("error setting table_with_columns: %w", err)
+			}
+		}
+		d.Set("table", nil)
+	case tflakeformation.TableTypeTable:
+		if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			for _, perm := range cleanPermissions {
+				if perm.Resource.Table != nil {
+					tfMap = flattenLakeFormationTableResource(perm.Resource.Table)
+					break
+				}
+			}
+
+			if err := d.Set("table", []interface{}{tfMap}); err != nil {
+				return fmt.Errorf("error setting table: %w", err)
+			}
+		}
+		d.Set("table_with_columns", nil)
+	default:
+		d.Set("table", nil)
+		d.Set("table_with_columns", nil)
+	}
+
+	return nil
+}
+
+func expandLakeFormationDataLocationResource(tfMap map[string]interface{}) *lakeformation.DataLocationResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.DataLocationResource{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.ResourceArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLakeFormationDataLocationResource(apiObject *lakeformation.DataLocationResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ResourceArn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func expandLakeFormationDatabaseResource(tfMap map[string]interface{}) *lakeformation.DatabaseResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.DatabaseResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLakeFormationDatabaseResource(apiObject *lakeformation.DatabaseResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func expandLakeFormationTableResource(tfMap map[string]interface{}) *lakeformation.TableResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.TableResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" && v != tflakeformation.TableNameAllTables {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["wildcard"].(bool); ok && v {
+		apiObject.TableWildcard = &lakeformation.TableWildcard{}
+		apiObject.Name = nil
+	}
+
+	return apiObject
+}
+
+func flattenLakeFormationTableResource(apiObject *lakeformation.TableResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap["database_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil && aws.StringValue(v) != tflakeformation.TableNameAllTables {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	tfMap["wildcard"] = apiObject.TableWildcard != nil || aws.StringValue(apiObject.Name) == tflakeformation.TableNameAllTables
+
+	return tfMap
+}
+
+func expandLakeFormationTableWithColumnsResource(tfMap map[string]interface{}) *lakeformation.TableWithColumnsResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.TableWithColumnsResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["column_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ColumnNames = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["wildcard"].(bool); ok && v {
+		apiObject.ColumnWildcard = &lakeformation.ColumnWildcard{}
+	}
+
+	if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+		if apiObject.ColumnWildcard == nil {
+			apiObject.ColumnWildcard = &lakeformation.ColumnWildcard{}
+		}
+		apiObject.ColumnWildcard.ExcludedColumnNames = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandLakeFormationTableWithColumnsResourceAsTable(tfMap map[string]interface{}) *lakeformation.TableResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.TableResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
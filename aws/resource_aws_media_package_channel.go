@@ -22,6 +22,8 @@ func resourceAwsMediaPackageChannel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -67,21 +69,24 @@ func resourceAwsMediaPackageChannel() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
 func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mediapackageconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &mediapackage.CreateChannelInput{
 		Id:          aws.String(d.Get("channel_id").(string)),
 		Description: aws.String(d.Get("description").(string)),
 	}
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		input.Tags = keyvaluetags.New(v).IgnoreAws().MediapackageTags()
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().MediapackageTags()
 	}
 
 	resp, err := conn.CreateChannel(input)
@@ -97,6 +102,7 @@ func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface
 func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mediapackageconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	input := &mediapackage.DescribeChannelInput{
 		Id: aws.String(d.Id()),
@@ -113,10 +119,17 @@ func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error setting hls_ingest: %s", err)
 	}
 
-	if err := d.Set("tags", keyvaluetags.MediapackageKeyValueTags(resp.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.MediapackageKeyValueTags(resp.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -134,8 +147,8 @@ func resourceAwsMediaPackageChannelUpdate(d *schema.ResourceData, meta interface
 	}
 
 	arn := d.Get("arn").(string)
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.MediapackageUpdateTags(conn, arn, o, n); err != nil {
 			return fmt.Errorf("error updating MediaPackage Channel (%s) tags: %s", arn, err)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
@@ -22,20 +23,23 @@ func resourceAwsMqConfiguration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-			if diff.HasChange("description") {
-				return diff.SetNewComputed("latest_revision")
-			}
-			if diff.HasChange("data") {
-				o, n := diff.GetChange("data")
-				os := o.(string)
-				ns := n.(string)
-				if !suppressXMLEquivalentConfig("data", os, ns, nil) {
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				if diff.HasChange("description") {
 					return diff.SetNewComputed("latest_revision")
 				}
-			}
-			return nil
-		},
+				if diff.HasChange("data") {
+					o, n := diff.GetChange("data")
+					os := o.(string)
+					ns := n.(string)
+					if !suppressXMLEquivalentConfig("data", os, ns, nil) {
+						return diff.SetNewComputed("latest_revision")
+					}
+				}
+				return nil
+			},
+			SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -73,13 +77,16 @@ func resourceAwsMqConfiguration() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
 func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mqconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	input := mq.CreateConfigurationRequest{
 		EngineType:    aws.String(d.Get("engine_type").(string)),
@@ -87,8 +94,8 @@ func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{})
 		Name:          aws.String(d.Get("name").(string)),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().MqTags()
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().MqTags()
 	}
 
 	log.Printf("[INFO] Creating MQ Configuration: %s", input)
@@ -106,6 +113,7 @@ func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{})
 func resourceAwsMqConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mqconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	log.Printf("[INFO] Reading MQ Configuration %s", d.Id())
 	out, err := conn.DescribeConfiguration(&mq.DescribeConfigurationInput{
@@ -142,10 +150,17 @@ func resourceAwsMqConfigurationRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("data", string(b))
 
-	if err := d.Set("tags", keyvaluetags.MqKeyValueTags(out.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.MqKeyValueTags(out.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -171,8 +186,8 @@ func resourceAwsMqConfigurationUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.MqUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating MQ Broker (%s) tags: %s", d.Get("arn").(string), err)
@@ -3,15 +3,16 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsNatGateway() *schema.Resource {
@@ -24,6 +25,8 @@ func resourceAwsNatGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
 				Type:     schema.TypeString,
@@ -52,19 +55,22 @@ func resourceAwsNatGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
 func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	// Create the NAT Gateway
 	createOpts := &ec2.CreateNatGatewayInput{
 		AllocationId:      aws.String(d.Get("allocation_id").(string)),
 		SubnetId:          aws.String(d.Get("subnet_id").(string)),
-		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeNatgateway),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNatgateway),
 	}
 
 	log.Printf("[DEBUG] Create NAT Gateway: %s", *createOpts)
@@ -80,14 +86,7 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 
 	// Wait for the NAT Gateway to become available
 	log.Printf("[DEBUG] Waiting for NAT Gateway (%s) to become available", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{ec2.NatGatewayStatePending},
-		Target:  []string{ec2.NatGatewayStateAvailable},
-		Refresh: NGStateRefreshFunc(conn, d.Id()),
-		Timeout: 10 * time.Minute,
-	}
-
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waiter.NatGatewayAvailable(conn, d.Id()); err != nil {
 		return fmt.Errorf("Error waiting for NAT Gateway (%s) to become available: %s", d.Id(), err)
 	}
 
@@ -97,27 +96,31 @@ func resourceAwsNatGatewayCreate(d *schema.ResourceData, meta interface{}) error
 func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
-	// Refresh the NAT Gateway state
-	ngRaw, state, err := NGStateRefreshFunc(conn, d.Id())()
-	if err != nil {
-		return err
+	ngRaw, err := tfresource.RetryWhenNewResourceNotFound(waiter.PropagationTimeout, func() (interface{}, error) {
+		return finder.NatGatewayByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] NAT Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
-	status := map[string]bool{
-		ec2.NatGatewayStateDeleted:  true,
-		ec2.NatGatewayStateDeleting: true,
-		ec2.NatGatewayStateFailed:   true,
+	if err != nil {
+		return fmt.Errorf("error reading NAT Gateway (%s): %w", d.Id(), err)
 	}
 
-	if _, ok := status[strings.ToLower(state)]; ngRaw == nil || ok {
-		log.Printf("[INFO] Removing %s from Terraform state as it is not found or in the deleted state.", d.Id())
+	ng := ngRaw.(*ec2.NatGateway)
+
+	if state := aws.StringValue(ng.State); state == ec2.NatGatewayStateDeleting || state == ec2.NatGatewayStateFailed {
+		log.Printf("[WARN] NAT Gateway (%s) in state (%s), removing from state", d.Id(), state)
 		d.SetId("")
 		return nil
 	}
 
 	// Set NAT Gateway attributes
-	ng := ngRaw.(*ec2.NatGateway)
 	d.Set("subnet_id", ng.SubnetId)
 
 	// Address
@@ -127,18 +130,25 @@ func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("private_ip", address.PrivateIp)
 	d.Set("public_ip", address.PublicIp)
 
-	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(ng.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.Ec2KeyValueTags(ng.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating EC2 NAT Gateway (%s) tags: %s", d.Id(), err)
@@ -156,60 +166,18 @@ func resourceAwsNatGatewayDelete(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Deleting NAT Gateway: %s", d.Id())
 
 	_, err := conn.DeleteNatGateway(deleteOpts)
-	if err != nil {
-		ec2err, ok := err.(awserr.Error)
-		if !ok {
-			return err
-		}
-
-		if ec2err.Code() == "NatGatewayNotFound" {
-			return nil
-		}
 
-		return err
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeNatGatewayNotFound) {
+		return nil
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{ec2.NatGatewayStateDeleting},
-		Target:     []string{ec2.NatGatewayStateDeleted},
-		Refresh:    NGStateRefreshFunc(conn, d.Id()),
-		Timeout:    30 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	if err != nil {
+		return fmt.Errorf("error deleting NAT Gateway (%s): %w", d.Id(), err)
 	}
 
-	_, stateErr := stateConf.WaitForState()
-	if stateErr != nil {
-		return fmt.Errorf("Error waiting for NAT Gateway (%s) to delete: %s", d.Id(), err)
+	if _, err := waiter.NatGatewayDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("Error waiting for NAT Gateway (%s) to delete: %w", d.Id(), err)
 	}
 
 	return nil
 }
-
-// NGStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// a NAT Gateway.
-func NGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		opts := &ec2.DescribeNatGatewaysInput{
-			NatGatewayIds: []*string{aws.String(id)},
-		}
-		resp, err := conn.DescribeNatGateways(opts)
-		if err != nil {
-			if isAWSErr(err, "NatGatewayNotFound", "") {
-				resp = nil
-			} else {
-				log.Printf("Error on NGStateRefresh: %s", err)
-				return nil, "", err
-			}
-		}
-
-		if resp == nil {
-			// Sometimes AWS just has consistency issues and doesn't see
-			// our instance yet. Return an empty state.
-			return nil, "", nil
-		}
-
-		ng := resp.NatGateways[0]
-		return ng, *ng.State, nil
-	}
-}
@@ -15,6 +15,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsNetworkInterface() *schema.Resource {
@@ -27,6 +30,8 @@ func resourceAwsNetworkInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 
 			"subnet_id": {
@@ -110,7 +115,8 @@ func resourceAwsNetworkInterface() *schema.Resource {
 				Set: resourceAwsEniAttachmentHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"ipv6_address_count": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -134,10 +140,12 @@ func resourceAwsNetworkInterface() *schema.Resource {
 func resourceAwsNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	request := &ec2.CreateNetworkInterfaceInput{
 		SubnetId:          aws.String(d.Get("subnet_id").(string)),
-		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeNetworkInterface),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNetworkInterface),
 	}
 
 	if v, ok := d.GetOk("security_groups"); ok && v.(*schema.Set).Len() > 0 {
@@ -172,7 +180,7 @@ func resourceAwsNetworkInterfaceCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(aws.StringValue(resp.NetworkInterface.NetworkInterfaceId))
 
-	if err := waitForNetworkInterfaceCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waiter.NetworkInterfaceAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for Network Interface (%s) creation: %s", d.Id(), err)
 	}
 
@@ -209,27 +217,23 @@ func resourceAwsNetworkInterfaceCreate(d *schema.ResourceData, meta interface{})
 func resourceAwsNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+
+	eniRaw, err := tfresource.RetryWhenNewResourceNotFound(waiter.PropagationTimeout, func() (interface{}, error) {
+		return finder.NetworkInterfaceByID(conn, d.Id())
+	}, d.IsNewResource())
 
-	describe_network_interfaces_request := &ec2.DescribeNetworkInterfacesInput{
-		NetworkInterfaceIds: []*string{aws.String(d.Id())},
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Interface (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
-	describeResp, err := conn.DescribeNetworkInterfaces(describe_network_interfaces_request)
 
 	if err != nil {
-		if isAWSErr(err, "InvalidNetworkInterfaceID.NotFound", "") {
-			// The ENI is gone now, so just remove it from the state
-			log.Printf("[WARN] EC2 Network Interface (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-
-		return fmt.Errorf("Error retrieving ENI: %s", err)
-	}
-	if len(describeResp.NetworkInterfaces) != 1 {
-		return fmt.Errorf("Unable to find ENI: %#v", describeResp.NetworkInterfaces)
+		return fmt.Errorf("error reading EC2 Network Interface (%s): %w", d.Id(), err)
 	}
 
-	eni := describeResp.NetworkInterfaces[0]
+	eni := eniRaw.(*ec2.NetworkInterface)
 
 	attachment := []map[string]interface{}{}
 
@@ -265,10 +269,17 @@ func resourceAwsNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("error setting ipv6 addresses: %s", err)
 	}
 
-	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(eni.TagSet).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.Ec2KeyValueTags(eni.TagSet).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -539,8 +550,8 @@ func resourceAwsNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating EC2 Network Interface (%s) tags: %s", d.Id(), err)
@@ -678,46 +689,3 @@ func networkInterfaceAttachmentStateRefresh(conn *ec2.EC2, eniId string) resourc
 		}
 	}
 }
-
-func networkInterfaceStateRefresh(conn *ec2.EC2, eniId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
-			NetworkInterfaceIds: aws.StringSlice([]string{eniId}),
-		})
-
-		if isAWSErr(err, "InvalidNetworkInterfaceID.NotFound", "") {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", fmt.Errorf("error describing ENI (%s): %s", eniId, err)
-		}
-
-		n := len(resp.NetworkInterfaces)
-		switch n {
-		case 0:
-			return nil, "", nil
-
-		case 1:
-			eni := resp.NetworkInterfaces[0]
-			return eni, aws.StringValue(eni.Status), nil
-
-		default:
-			return nil, "", fmt.Errorf("found %d ENIs for %s, expected 1", n, eniId)
-		}
-	}
-}
-
-func waitForNetworkInterfaceCreation(conn *ec2.EC2, id string, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"pending"},
-		Target:  []string{ec2.NetworkInterfaceStatusAvailable},
-		Refresh: networkInterfaceStateRefresh(conn, id),
-		Timeout: timeout,
-		Delay:   30 * time.Second,
-	}
-
-	_, err := stateConf.WaitForState()
-
-	return err
-}
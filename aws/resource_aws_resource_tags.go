@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsResourceTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsResourceTagsCreate,
+		Read:   resourceAwsResourceTagsRead,
+		Update: resourceAwsResourceTagsUpdate,
+		Delete: resourceAwsResourceTagsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsResourceTagsCreate(d *schema.ResourceData, meta interface{}) error {
+	resourceARN := d.Get("resource_arn").(string)
+
+	if err := resourceAwsResourceTagsUpdateTags(meta.(*AWSClient), resourceARN, nil, d.Get("tags").(map[string]interface{})); err != nil {
+		return fmt.Errorf("error creating tags for resource (%s): %w", resourceARN, err)
+	}
+
+	d.SetId(resourceARN)
+
+	return resourceAwsResourceTagsRead(d, meta)
+}
+
+func resourceAwsResourceTagsRead(d *schema.ResourceData, meta interface{}) error {
+	tags, err := resourceAwsResourceTagsListTags(meta.(*AWSClient), d.Id())
+
+	if !d.IsNewResource() && isResourceTagsResourceNotFoundError(err) {
+		log.Printf("[WARN] Resource (%s) not found, removing tags from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for resource (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws()
+
+	// Only the configured tag keys are managed, other tags on the resource are left untouched.
+	// All tags are managed following import.
+	if v, ok := d.GetOk("tags"); ok {
+		tags = tags.Only(keyvaluetags.New(v.(map[string]interface{})))
+	}
+
+	d.Set("resource_arn", d.Id())
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsResourceTagsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := resourceAwsResourceTagsUpdateTags(meta.(*AWSClient), d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating tags for resource (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsResourceTagsRead(d, meta)
+}
+
+func resourceAwsResourceTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	err := resourceAwsResourceTagsUpdateTags(meta.(*AWSClient), d.Id(), d.Get("tags").(map[string]interface{}), nil)
+
+	if isResourceTagsResourceNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting tags for resource (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsResourceTagsListTags lists the tags of the resource with the specified ARN.
+// Resources not supported by the Resource Groups Tagging API use the service's own tagging API.
+func resourceAwsResourceTagsListTags(client *AWSClient, resourceARN string) (keyvaluetags.KeyValueTags, error) {
+	parsedARN, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return keyvaluetags.New(nil), err
+	}
+
+	resourceType, resourceName := resourceAwsResourceTagsParseResource(parsedARN)
+
+	switch {
+	case parsedARN.Service == iam.ServiceName && resourceType == "role":
+		return keyvaluetags.IamRoleListTags(client.iamconn, resourceName)
+	case parsedARN.Service == iam.ServiceName && resourceType == "user":
+		return keyvaluetags.IamUserListTags(client.iamconn, resourceName)
+	case parsedARN.Service == route53.ServiceName && resourceType == route53.TagResourceTypeHostedzone:
+		return keyvaluetags.Route53ListTags(client.r53conn, resourceName, route53.TagResourceTypeHostedzone)
+	case parsedARN.Service == route53.ServiceName && resourceType == route53.TagResourceTypeHealthcheck:
+		return keyvaluetags.Route53ListTags(client.r53conn, resourceName, route53.TagResourceTypeHealthcheck)
+	}
+
+	return keyvaluetags.ResourcegroupstaggingapiListTags(client.resourcegroupstaggingapiconn, resourceARN)
+}
+
+// resourceAwsResourceTagsUpdateTags updates the tags of the resource with the specified ARN.
+// Resources not supported by the Resource Groups Tagging API use the service's own tagging API.
+func resourceAwsResourceTagsUpdateTags(client *AWSClient, resourceARN string, oldTags, newTags interface{}) error {
+	parsedARN, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return err
+	}
+
+	resourceType, resourceName := resourceAwsResourceTagsParseResource(parsedARN)
+
+	switch {
+	case parsedARN.Service == iam.ServiceName && resourceType == "role":
+		return keyvaluetags.IamRoleUpdateTags(client.iamconn, resourceName, oldTags, newTags)
+	case parsedARN.Service == iam.ServiceName && resourceType == "user":
+		return keyvaluetags.IamUserUpdateTags(client.iamconn, resourceName, oldTags, newTags)
+	case parsedARN.Service == route53.ServiceName && resourceType == route53.TagResourceTypeHostedzone:
+		return keyvaluetags.Route53UpdateTags(client.r53conn, resourceName, route53.TagResourceTypeHostedzone, oldTags, newTags)
+	case parsedARN.Service == route53.ServiceName && resourceType == route53.TagResourceTypeHealthcheck:
+		return keyvaluetags.Route53UpdateTags(client.r53conn, resourceName, route53.TagResourceTypeHealthcheck, oldTags, newTags)
+	}
+
+	return keyvaluetags.ResourcegroupstaggingapiUpdateTags(client.resourcegroupstaggingapiconn, resourceARN, oldTags, newTags)
+}
+
+// resourceAwsResourceTagsParseResource returns the resource type and name from an ARN
+// with a resource of the form type/[path/]name.
+func resourceAwsResourceTagsParseResource(parsedARN arn.ARN) (string, string) {
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) < 2 {
+		return "", parsedARN.Resource
+	}
+
+	return parts[0], parts[len(parts)-1]
+}
+
+// isResourceTagsResourceNotFoundError returns true if the error indicates that a resource
+// tagged using the service's own tagging API does not exist.
+func isResourceTagsResourceNotFoundError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) ||
+		tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) ||
+		tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHealthCheck)
+}
@@ -0,0 +1,261 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSResourceTags_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceTagsConfigSqsQueue(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSResourceTags_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceTagsConfigSqsQueue(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsResourceTags(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSResourceTags_Tags(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceTagsConfigSqsQueue(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSResourceTagsConfigSqsQueue2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSResourceTagsConfigSqsQueue(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSResourceTags_IamRole(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resource_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSResourceTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSResourceTagsConfigIamRole(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSResourceTagsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSResourceTagsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*AWSClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resource_tags" {
+			continue
+		}
+
+		tags, err := resourceAwsResourceTagsListTags(client, rs.Primary.ID)
+
+		if isResourceTagsResourceNotFoundError(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for k := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "tags.") || k == "tags.%" {
+				continue
+			}
+
+			if key := strings.TrimPrefix(k, "tags."); tags.KeyExists(key) {
+				return fmt.Errorf("Tag (%s) for resource (%s) still exists", key, rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSResourceTagsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AWSClient)
+
+		tags, err := resourceAwsResourceTagsListTags(client, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(tags) == 0 {
+			return fmt.Errorf("Tags for resource (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSResourceTagsConfigSqsQueue(rName, key1, value1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sqs_queue.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, key1, value1)
+}
+
+func testAccAWSResourceTagsConfigSqsQueue2(rName, key1, value1, key2, value2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sqs_queue.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, key1, value1, key2, value2)
+}
+
+func testAccAWSResourceTagsConfigIamRole(rName, key1, value1 string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_iam_role.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, key1, value1)
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfroute53 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSRoute53HostedZoneDnssec_basic(t *testing.T) {
+	route53ZoneResourceName := "aws_route53_zone.test"
+	resourceName := "aws_route53_hosted_zone_dnssec.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domainName := fmt.Sprintf("%s.terraformtest.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53KeySigningKey(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53HostedZoneDnssecDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "hosted_zone_id", route53ZoneResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureSigning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53HostedZoneDnssec_disappears(t *testing.T) {
+	resourceName := "aws_route53_hosted_zone_dnssec.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domainName := fmt.Sprintf("%s.terraformtest.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53KeySigningKey(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53HostedZoneDnssecDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRoute53HostedZoneDnssec(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53HostedZoneDnssec_SigningStatus(t *testing.T) {
+	resourceName := "aws_route53_hosted_zone_dnssec.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domainName := fmt.Sprintf("%s.terraformtest.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53KeySigningKey(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53HostedZoneDnssecDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig_SigningStatus(rName, domainName, tfroute53.ServeSignatureNotSigning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureNotSigning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig_SigningStatus(rName, domainName, tfroute53.ServeSignatureSigning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureSigning),
+				),
+			},
+			{
+				Config: testAccAwsRoute53HostedZoneDnssecConfig_SigningStatus(rName, domainName, tfroute53.ServeSignatureNotSigning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsRoute53HostedZoneDnssecExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureNotSigning),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRoute53HostedZoneDnssecDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_hosted_zone_dnssec" {
+			continue
+		}
+
+		output, err := finder.HostedZoneDnssec(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Status.ServeSignature) == tfroute53.ServeSignatureNotSigning {
+			continue
+		}
+
+		return fmt.Errorf("Route 53 Hosted Zone DNSSEC %s still signing", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsRoute53HostedZoneDnssecExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Hosted Zone DNSSEC ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		_, err := finder.HostedZoneDnssec(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAwsRoute53HostedZoneDnssecConfig(rName, domainName string) string {
+	return composeConfig(
+		testAccAwsRoute53KeySigningKeyConfig_Base(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name                       = %[1]q
+}
+
+resource "aws_route53_hosted_zone_dnssec" "test" {
+  hosted_zone_id = aws_route53_key_signing_key.test.hosted_zone_id
+}
+`, rName))
+}
+
+func testAccAwsRoute53HostedZoneDnssecConfig_SigningStatus(rName, domainName, signingStatus string) string {
+	return composeConfig(
+		testAccAwsRoute53KeySigningKeyConfig_Base(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name                       = %[1]q
+}
+
+resource "aws_route53_hosted_zone_dnssec" "test" {
+  hosted_zone_id = aws_route53_key_signing_key.test.hosted_zone_id
+  signing_status = %[2]q
+}
+`, rName, signingStatus))
+}
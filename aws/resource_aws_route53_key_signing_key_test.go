@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfroute53 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSRoute53KeySigningKey_basic(t *testing.T) {
+	kmsKeyResourceName := "aws_kms_key.test"
+	route53ZoneResourceName := "aws_route53_zone.test"
+	resourceName := "aws_route53_key_signing_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domainName := fmt.Sprintf("%s.terraformtest.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53KeySigningKey(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53KeySigningKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53KeySigningKeyConfig_Name(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAwsRoute53KeySigningKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "digest_algorithm_mnemonic", "SHA-256"),
+					resource.TestCheckResourceAttr(resourceName, "digest_algorithm_type", "2"),
+					resource.TestMatchResourceAttr(resourceName, "digest_value", regexp.MustCompile(`^[0-9A-F]+$`)),
+					resource.TestMatchResourceAttr(resourceName, "dnskey_record", regexp.MustCompile(`^257 [0-9]+ [0-9]+ [a-zA-Z0-9+/]+={0,3}$`)),
+					resource.TestMatchResourceAttr(resourceName, "ds_record", regexp.MustCompile(`^[0-9]+ [0-9]+ [0-9]+ [0-9A-F]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "flag", "257"),
+					resource.TestCheckResourceAttrPair(resourceName, "hosted_zone_id", route53ZoneResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_management_service_arn", kmsKeyResourceName, "arn"),
+					resource.TestMatchResourceAttr(resourceName, "key_tag", regexp.MustCompile(`^[0-9]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestMatchResourceAttr(resourceName, "public_key", regexp.MustCompile(`^[a-zA-Z0-9+/]+={0,3}$`)),
+					resource.TestCheckResourceAttr(resourceName, "signing_algorithm_mnemonic", "ECDSAP256SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "signing_algorithm_type", "13"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfroute53.KeySigningKeyStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53KeySigningKey_disappears(t *testing.T) {
+	resourceName := "aws_route53_key_signing_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domainName := fmt.Sprintf("%s.terraformtest.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53KeySigningKey(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53KeySigningKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53KeySigningKeyConfig_Name(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsRoute53KeySigningKeyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRoute53KeySigningKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53KeySigningKey_Status(t *testing.T) {
+	resourceName := "aws_route53_key_signing_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domainName := fmt.Sprintf("%s.terraformtest.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoute53KeySigningKey(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRoute53KeySigningKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRoute53KeySigningKeyConfig_Status(rName, domainName, tfroute53.KeySigningKeyStatusInactive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsRoute53KeySigningKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", tfroute53.KeySigningKeyStatusInactive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsRoute53KeySigningKeyConfig_Status(rName, domainName, tfroute53.KeySigningKeyStatusActive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsRoute53KeySigningKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", tfroute53.KeySigningKeyStatusActive),
+				),
+			},
+			{
+				Config: testAccAwsRoute53KeySigningKeyConfig_Status(rName, domainName, tfroute53.KeySigningKeyStatusInactive),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsRoute53KeySigningKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", tfroute53.KeySigningKeyStatusInactive),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckRoute53KeySigningKey skips tests outside US East (N. Virginia),
+// the only region in which Route 53 can use KMS keys for DNSSEC signing.
+func testAccPreCheckRoute53KeySigningKey(t *testing.T) {
+	testAccPartitionHasServicePreCheck(route53.EndpointsID, t)
+	testAccRegionPreCheck(t, endpoints.UsEast1RegionID)
+}
+
+func testAccCheckAwsRoute53KeySigningKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_key_signing_key" {
+			continue
+		}
+
+		_, err := finder.KeySigningKeyByResourceID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 Key Signing Key %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsRoute53KeySigningKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Key Signing Key ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		_, err := finder.KeySigningKeyByResourceID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAwsRoute53KeySigningKeyConfig_Base(rName, domainName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_kms_key" "test" {
+  customer_master_key_spec = "ECC_NIST_P256"
+  deletion_window_in_days  = 7
+  key_usage                = "SIGN_VERIFY"
+  policy = jsonencode({
+    Statement = [
+      {
+        Action = [
+          "kms:DescribeKey",
+          "kms:GetPublicKey",
+          "kms:Sign",
+        ],
+        Effect = "Allow"
+        Principal = {
+          Service = "dnssec-route53.amazonaws.com"
+        }
+        Sid      = "Allow Route 53 DNSSEC Service"
+        Resource = "*"
+      },
+      {
+        Action = "kms:CreateGrant",
+        Effect = "Allow"
+        Principal = {
+          Service = "dnssec-route53.amazonaws.com"
+        }
+        Sid      = "Allow Route 53 DNSSEC Service to CreateGrant"
+        Resource = "*"
+        Condition = {
+          Bool = {
+            "kms:GrantIsForAWSResource" = "true"
+          }
+        }
+      },
+      {
+        Action = "kms:*"
+        Effect = "Allow"
+        Principal = {
+          AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+        }
+        Resource = "*"
+        Sid      = "IAM User Permissions"
+      },
+    ]
+    Version = "2012-10-17"
+  })
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+}
+`, rName, domainName)
+}
+
+func testAccAwsRoute53KeySigningKeyConfig_Name(rName, domainName string) string {
+	return composeConfig(
+		testAccAwsRoute53KeySigningKeyConfig_Base(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name                       = %[1]q
+}
+`, rName))
+}
+
+func testAccAwsRoute53KeySigningKeyConfig_Status(rName, domainName, status string) string {
+	return composeConfig(
+		testAccAwsRoute53KeySigningKeyConfig_Base(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name                       = %[1]q
+  status                     = %[2]q
+}
+`, rName, status))
+}
@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/finder"
+)
+
+func TestAccAWSRoute53ResolverDnssecConfig_basic(t *testing.T) {
+	var config route53resolver.ResolverDnssecConfig
+	resourceName := "aws_route53_resolver_dnssec_config.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Resolver(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53ResolverDnssecConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53ResolverDnssecConfigConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverDnssecConfigExists(resourceName, &config),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "route53resolver", regexp.MustCompile(`resolver-dnssec-config/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "validation_status", route53resolver.ResolverDNSSECValidationStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53ResolverDnssecConfig_disappear(t *testing.T) {
+	var config route53resolver.ResolverDnssecConfig
+	resourceName := "aws_route53_resolver_dnssec_config.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Resolver(t) },
+		ErrorCheck:   testAccErrorCheckSkipRoute53(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53ResolverDnssecConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53ResolverDnssecConfigConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverDnssecConfigExists(resourceName, &config),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsRoute53ResolverDnssecConfig(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53ResolverDnssecConfigDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_resolver_dnssec_config" {
+			continue
+		}
+
+		config, err := finder.ResolverDnssecConfigByID(conn, rs.Primary.ID)
+
+		if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if config == nil || aws.StringValue(config.ValidationStatus) == route53resolver.ResolverDNSSECValidationStatusDisabled {
+			continue
+		}
+
+		return fmt.Errorf("Route 53 Resolver DNSSEC config still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRoute53ResolverDnssecConfigExists(n string, v *route53resolver.ResolverDnssecConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Resolver DNSSEC config ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+
+		config, err := finder.ResolverDnssecConfigByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if config == nil {
+			return fmt.Errorf("Route 53 Resolver DNSSEC config (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *config
+
+		return nil
+	}
+}
+
+func testAccRoute53ResolverDnssecConfigConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_resolver_dnssec_config" "test" {
+  resource_id = aws_vpc.test.id
+}
+`, rName)
+}
@@ -14,6 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 var routeTableValidDestinations = []string{
@@ -43,6 +46,8 @@ func resourceAwsRouteTable() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -50,7 +55,8 @@ func resourceAwsRouteTable() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 
 			"propagating_vgws": {
 				Type:     schema.TypeSet,
@@ -144,11 +150,13 @@ func resourceAwsRouteTable() *schema.Resource {
 
 func resourceAwsRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	// Create the routing table
 	createOpts := &ec2.CreateRouteTableInput{
 		VpcId:             aws.String(d.Get("vpc_id").(string)),
-		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeRouteTable),
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeRouteTable),
 	}
 	log.Printf("[DEBUG] RouteTable create config: %#v", createOpts)
 
@@ -163,17 +171,8 @@ func resourceAwsRouteTableCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Route Table ID: %s", d.Id())
 
 	// Wait for the route table to become available
-	log.Printf(
-		"[DEBUG] Waiting for route table (%s) to become available",
-		d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending:        []string{"pending"},
-		Target:         []string{"ready"},
-		Refresh:        resourceAwsRouteTableStateRefreshFunc(conn, d.Id()),
-		Timeout:        10 * time.Minute,
-		NotFoundChecks: 40,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	log.Printf("[DEBUG] Waiting for route table (%s) to become available", d.Id())
+	if _, err := waiter.RouteTableReady(conn, d.Id()); err != nil {
 		return fmt.Errorf(
 			"Error waiting for route table (%s) to become available: %s",
 			d.Id(), err)
@@ -185,16 +184,22 @@ func resourceAwsRouteTableCreate(d *schema.ResourceData, meta interface{}) error
 func resourceAwsRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
-	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, d.Id())()
-	if err != nil {
-		return err
-	}
-	if rtRaw == nil {
+	rtRaw, err := tfresource.RetryWhenNewResourceNotFound(waiter.PropagationTimeout, func() (interface{}, error) {
+		return finder.RouteTableByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route Table (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Route Table (%s): %w", d.Id(), err)
+	}
+
 	rt := rtRaw.(*ec2.RouteTable)
 	d.Set("vpc_id", rt.VpcId)
 
@@ -265,10 +270,17 @@ func resourceAwsRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("route", route)
 
 	// Tags
-	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(rt.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.Ec2KeyValueTags(rt.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
 	d.Set("owner_id", rt.OwnerId)
 
 	return nil
@@ -463,8 +475,8 @@ func resourceAwsRouteTableUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") && !d.IsNewResource() {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") && !d.IsNewResource() {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating EC2 Route Table (%s) tags: %w", d.Id(), err)
@@ -479,14 +491,15 @@ func resourceAwsRouteTableDelete(d *schema.ResourceData, meta interface{}) error
 
 	// First request the routing table since we'll have to disassociate
 	// all the subnets first.
-	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, d.Id())()
-	if err != nil {
-		return err
-	}
-	if rtRaw == nil {
+	rt, err := finder.RouteTableByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
 		return nil
 	}
-	rt := rtRaw.(*ec2.RouteTable)
+
+	if err != nil {
+		return fmt.Errorf("error reading Route Table (%s): %w", d.Id(), err)
+	}
 
 	// Do all the disassociations
 	for _, a := range rt.Associations {
@@ -521,17 +534,8 @@ func resourceAwsRouteTableDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Wait for the route table to really destroy
-	log.Printf(
-		"[DEBUG] Waiting for route table (%s) to become destroyed",
-		d.Id())
-
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"ready"},
-		Target:  []string{},
-		Refresh: resourceAwsRouteTableStateRefreshFunc(conn, d.Id()),
-		Timeout: 5 * time.Minute,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	log.Printf("[DEBUG] Waiting for route table (%s) to become destroyed", d.Id())
+	if _, err := waiter.RouteTableDeleted(conn, d.Id()); err != nil {
 		return fmt.Errorf(
 			"Error waiting for route table (%s) to become destroyed: %s",
 			d.Id(), err)
@@ -597,30 +601,3 @@ func resourceAwsRouteTableHash(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
-
-// resourceAwsRouteTableStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// a RouteTable.
-func resourceAwsRouteTableStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := conn.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
-			RouteTableIds: []*string{aws.String(id)},
-		})
-		if err != nil {
-			if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
-				resp = nil
-			} else {
-				log.Printf("Error on RouteTableStateRefresh: %s", err)
-				return nil, "", err
-			}
-		}
-
-		if resp == nil {
-			// Sometimes AWS just has consistency issues and doesn't see
-			// our instance yet. Return an empty state.
-			return nil, "", nil
-		}
-
-		rt := resp.RouteTables[0]
-		return rt, "ready", nil
-	}
-}
@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsRouteTableAssociation() *schema.Resource {
@@ -99,15 +101,18 @@ func resourceAwsRouteTableAssociationRead(d *schema.ResourceData, meta interface
 	conn := meta.(*AWSClient).ec2conn
 
 	// Get the routing table that this association belongs to
-	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(
-		conn, d.Get("route_table_id").(string))()
-	if err != nil {
-		return err
-	}
-	if rtRaw == nil {
+	rtID := d.Get("route_table_id").(string)
+	rt, err := finder.RouteTableByID(conn, rtID)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Route Table (%s) not found, removing Route Table Association (%s) from state", rtID, d.Id())
+		d.SetId("")
 		return nil
 	}
-	rt := rtRaw.(*ec2.RouteTable)
+
+	if err != nil {
+		return fmt.Errorf("error reading Route Table (%s): %w", rtID, err)
+	}
 
 	// Inspect that the association exists
 	found := false
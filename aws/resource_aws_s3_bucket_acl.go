@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3BucketAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAclPut,
+		Read:   resourceAwsS3BucketAclRead,
+		Update: resourceAwsS3BucketAclPut,
+		Delete: resourceAwsS3BucketAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"acl", "grant"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"grant": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Set:          grantHash,
+				ExactlyOneOf: []string{"acl", "grant"},
+				Elem:         s3BucketGrantSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAclPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	} else {
+		// The bucket owner must be included alongside explicit grants.
+		output, err := finder.BucketAcl(conn, bucket)
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", bucket, err)
+		}
+
+		input.AccessControlPolicy = &s3.AccessControlPolicy{
+			Grants: expandS3Grants(d.Get("grant").(*schema.Set).List()),
+			Owner:  output.Owner,
+		}
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket ACL: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketAclRead(d, meta)
+}
+
+func resourceAwsS3BucketAclRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(s3BucketPropagationTimeout, func() (interface{}, error) {
+		return finder.BucketAcl(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) ACL not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	// A canned ACL cannot be read back, so grants are only refreshed when no canned ACL is configured.
+	if _, ok := d.GetOk("acl"); ok {
+		return nil
+	}
+
+	if err := d.Set("grant", schema.NewSet(grantHash, flattenGrants(outputRaw.(*s3.GetBucketAclOutput)))); err != nil {
+		return fmt.Errorf("error setting grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAclDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	// Reset to the default canned ACL, which grants the bucket owner full control.
+	input := &s3.PutBucketAclInput{
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket ACL: %s", d.Id())
+	_, err := conn.PutBucketAcl(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return nil
+}
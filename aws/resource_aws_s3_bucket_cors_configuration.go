@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3BucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketCorsConfigurationPut,
+		Read:   resourceAwsS3BucketCorsConfigurationRead,
+		Update: resourceAwsS3BucketCorsConfigurationPut,
+		Delete: resourceAwsS3BucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cors_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     s3BucketCorsRuleSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketCorsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: expandS3CorsRules(d.Get("cors_rule").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket CORS Configuration: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketCors(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) CORS Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketCorsConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(s3BucketPropagationTimeout, func() (interface{}, error) {
+		return finder.BucketCorsRules(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) CORS Configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) CORS Configuration: %w", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("cors_rule", flattenS3CorsRules(outputRaw.([]*s3.CORSRule))); err != nil {
+		return fmt.Errorf("error setting cors_rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 Bucket CORS Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) CORS Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
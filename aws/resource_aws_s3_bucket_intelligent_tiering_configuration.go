@@ -0,0 +1,350 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3BucketIntelligentTieringConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketIntelligentTieringConfigurationPut,
+		Read:   resourceAwsS3BucketIntelligentTieringConfigurationRead,
+		Update: resourceAwsS3BucketIntelligentTieringConfigurationPut,
+		Delete: resourceAwsS3BucketIntelligentTieringConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							AtLeastOneOf: filterAtLeastOneOfKeys,
+						},
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							AtLeastOneOf: filterAtLeastOneOfKeys,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.IntelligentTieringStatusEnabled,
+				ValidateFunc: validation.StringInSlice(s3.IntelligentTieringStatus_Values(), false),
+			},
+			"tiering": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_tier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.IntelligentTieringAccessTier_Values(), false),
+						},
+						"days": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketIntelligentTieringConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucketName := d.Get("bucket").(string)
+	configurationName := d.Get("name").(string)
+	resourceID := resourceAwsS3BucketIntelligentTieringConfigurationCreateID(bucketName, configurationName)
+
+	intelligentTieringConfiguration := &s3.IntelligentTieringConfiguration{
+		Id:       aws.String(configurationName),
+		Filter:   expandS3IntelligentTieringFilter(d.Get("filter").([]interface{})),
+		Status:   aws.String(d.Get("status").(string)),
+		Tierings: expandS3Tierings(d.Get("tiering").(*schema.Set).List()),
+	}
+
+	input := &s3.PutBucketIntelligentTieringConfigurationInput{
+		Bucket:                          aws.String(bucketName),
+		Id:                              aws.String(configurationName),
+		IntelligentTieringConfiguration: intelligentTieringConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Intelligent-Tiering Configuration: %s", input)
+	_, err := tfresource.RetryWhen(1*time.Minute,
+		func() (interface{}, error) {
+			return conn.PutBucketIntelligentTieringConfiguration(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket Intelligent-Tiering Configuration (%s): %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceAwsS3BucketIntelligentTieringConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketIntelligentTieringConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucketName, configurationName, err := resourceAwsS3BucketIntelligentTieringConfigurationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := finder.BucketIntelligentTieringConfiguration(conn, bucketName, configurationName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Intelligent-Tiering Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Intelligent-Tiering Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("bucket", bucketName)
+	if err := d.Set("filter", flattenS3IntelligentTieringFilter(output.Filter)); err != nil {
+		return fmt.Errorf("error setting filter: %w", err)
+	}
+	d.Set("name", output.Id)
+	d.Set("status", output.Status)
+	if err := d.Set("tiering", flattenS3Tierings(output.Tierings)); err != nil {
+		return fmt.Errorf("error setting tiering: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketIntelligentTieringConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucketName, configurationName, err := resourceAwsS3BucketIntelligentTieringConfigurationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Intelligent-Tiering Configuration: %s", d.Id())
+	_, err = conn.DeleteBucketIntelligentTieringConfiguration(&s3.DeleteBucketIntelligentTieringConfigurationInput{
+		Bucket: aws.String(bucketName),
+		Id:     aws.String(configurationName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket Intelligent-Tiering Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketIntelligentTieringConfigurationCreateID(bucketName, configurationName string) string {
+	return strings.Join([]string{bucketName, configurationName}, ":")
+}
+
+func resourceAwsS3BucketIntelligentTieringConfigurationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET:NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandS3IntelligentTieringFilter(l []interface{}) *s3.IntelligentTieringFilter {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	var prefix string
+	if v, ok := m["prefix"].(string); ok {
+		prefix = v
+	}
+
+	var tags []*s3.Tag
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		tags = keyvaluetags.New(v).IgnoreAws().S3Tags()
+	}
+
+	if prefix == "" && len(tags) == 0 {
+		return nil
+	}
+
+	filter := &s3.IntelligentTieringFilter{}
+
+	if prefix != "" && len(tags) > 0 {
+		filter.And = &s3.IntelligentTieringAndOperator{
+			Prefix: aws.String(prefix),
+			Tags:   tags,
+		}
+	} else if len(tags) > 1 {
+		filter.And = &s3.IntelligentTieringAndOperator{
+			Tags: tags,
+		}
+	} else if len(tags) == 1 {
+		filter.Tag = tags[0]
+	} else {
+		filter.Prefix = aws.String(prefix)
+	}
+
+	return filter
+}
+
+func expandS3Tiering(tfMap map[string]interface{}) *s3.Tiering {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.Tiering{}
+
+	if v, ok := tfMap["access_tier"].(string); ok && v != "" {
+		apiObject.AccessTier = aws.String(v)
+	}
+
+	if v, ok := tfMap["days"].(int); ok && v != 0 {
+		apiObject.Days = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandS3Tierings(tfList []interface{}) []*s3.Tiering {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*s3.Tiering
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandS3Tiering(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenS3IntelligentTieringFilter(apiObject *s3.IntelligentTieringFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		if v := v.Prefix; v != nil {
+			tfMap["prefix"] = aws.StringValue(v)
+		}
+
+		if v := v.Tags; v != nil {
+			tfMap["tags"] = keyvaluetags.S3KeyValueTags(v).IgnoreAws().Map()
+		}
+	} else if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	} else if v := apiObject.Tag; v != nil {
+		tfMap["tags"] = keyvaluetags.S3KeyValueTags([]*s3.Tag{v}).IgnoreAws().Map()
+	} else {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3Tiering(apiObject *s3.Tiering) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccessTier; v != nil {
+		tfMap["access_tier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Days; v != nil {
+		tfMap["days"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3Tierings(apiObjects []*s3.Tiering) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenS3Tiering(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSS3BucketIntelligentTieringConfiguration_basic(t *testing.T) {
+	var itc s3.IntelligentTieringConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", bucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "DEEP_ARCHIVE_ACCESS",
+						"days":        "180",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketIntelligentTieringConfiguration_disappears(t *testing.T) {
+	var itc s3.IntelligentTieringConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3BucketIntelligentTieringConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketIntelligentTieringConfiguration_Filter(t *testing.T) {
+	var itc s3.IntelligentTieringConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterPrefix(rName, "p1/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "p1/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "ARCHIVE_ACCESS",
+						"days":        "90",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": "DEEP_ARCHIVE_ACCESS",
+						"days":        "180",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterSingleTag(rName, "Environment", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.Environment", "test"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterPrefixAndTags(rName, "p2/", "Environment", "test", "Project", "example"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "p2/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.Environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.Project", "example"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketIntelligentTieringConfigurationConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketIntelligentTieringConfigurationExists(n string, v *s3.IntelligentTieringConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Intelligent-Tiering Configuration ID is set")
+		}
+
+		bucketName, configurationName, err := resourceAwsS3BucketIntelligentTieringConfigurationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := finder.BucketIntelligentTieringConfiguration(conn, bucketName, configurationName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketIntelligentTieringConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_intelligent_tiering_configuration" {
+			continue
+		}
+
+		bucketName, configurationName, err := resourceAwsS3BucketIntelligentTieringConfigurationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.BucketIntelligentTieringConfiguration(conn, bucketName, configurationName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Intelligent-Tiering Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigBucket(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigBasic(rName string) string {
+	return composeConfig(testAccAWSS3BucketIntelligentTieringConfigurationConfigBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+`, rName))
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterPrefix(rName, prefix string) string {
+	return composeConfig(testAccAWSS3BucketIntelligentTieringConfigurationConfigBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+  status = "Disabled"
+
+  filter {
+    prefix = %[2]q
+  }
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = 90
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+`, rName, prefix))
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterSingleTag(rName, tagKey, tagValue string) string {
+	return composeConfig(testAccAWSS3BucketIntelligentTieringConfigurationConfigBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  filter {
+    tags = {
+      %[2]q = %[3]q
+    }
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+`, rName, tagKey, tagValue))
+}
+
+func testAccAWSS3BucketIntelligentTieringConfigurationConfigFilterPrefixAndTags(rName, prefix, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSS3BucketIntelligentTieringConfigurationConfigBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  filter {
+    prefix = %[2]q
+
+    tags = {
+      %[3]q = %[4]q
+      %[5]q = %[6]q
+    }
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+`, rName, prefix, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3BucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLifecycleConfigurationPut,
+		Read:   resourceAwsS3BucketLifecycleConfigurationRead,
+		Update: resourceAwsS3BucketLifecycleConfigurationPut,
+		Delete: resourceAwsS3BucketLifecycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"lifecycle_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     s3BucketLifecycleRuleSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketLifecycleConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	rules, err := expandS3LifecycleRules(d.Get("lifecycle_rule").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Lifecycle Configuration: %s", input)
+	_, err = retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfiguration(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(s3BucketPropagationTimeout, func() (interface{}, error) {
+		return finder.BucketLifecycleRules(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) Lifecycle Configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("lifecycle_rule", flattenS3LifecycleRules(outputRaw.([]*s3.LifecycleRule))); err != nil {
+		return fmt.Errorf("error setting lifecycle_rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Lifecycle Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
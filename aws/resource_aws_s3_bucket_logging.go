@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3BucketLogging() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLoggingPut,
+		Read:   resourceAwsS3BucketLoggingRead,
+		Update: resourceAwsS3BucketLoggingPut,
+		Delete: resourceAwsS3BucketLoggingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"target_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketLoggingPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(bucket),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: expandS3LoggingEnabled(map[string]interface{}{
+				"target_bucket": d.Get("target_bucket"),
+				"target_prefix": d.Get("target_prefix"),
+			}),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Logging: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketLogging(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Logging: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketLoggingRead(d, meta)
+}
+
+func resourceAwsS3BucketLoggingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(s3BucketPropagationTimeout, func() (interface{}, error) {
+		return finder.BucketLoggingEnabled(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) Logging not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Logging: %w", d.Id(), err)
+	}
+
+	lc := flattenS3LoggingEnabled(outputRaw.(*s3.LoggingEnabled))
+
+	d.Set("bucket", d.Id())
+	d.Set("target_bucket", lc["target_bucket"])
+	d.Set("target_prefix", lc["target_prefix"])
+
+	return nil
+}
+
+func resourceAwsS3BucketLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	// An empty logging status disables access logging.
+	input := &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{},
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Logging: %s", d.Id())
+	_, err := conn.PutBucketLogging(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Logging: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSS3BucketLogging_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_logging.test"
+	targetBucketResourceName := "aws_s3_bucket.log"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rName, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_bucket", targetBucketResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rName, "logs/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "logs/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketLogging_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_logging.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rName, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLoggingExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3BucketLogging(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketLoggingExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Logging ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := finder.BucketLoggingEnabled(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAWSS3BucketLoggingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_logging" {
+			continue
+		}
+
+		_, err := finder.BucketLoggingEnabled(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Logging %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketLoggingConfig(rName, targetPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log" {
+  bucket = "%[1]s-log"
+  acl    = "log-delivery-write"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  lifecycle {
+    ignore_changes = [logging]
+  }
+}
+
+resource "aws_s3_bucket_logging" "test" {
+  bucket        = aws_s3_bucket.test.id
+  target_bucket = aws_s3_bucket.log.id
+  target_prefix = %[2]q
+}
+`, rName, targetPrefix)
+}
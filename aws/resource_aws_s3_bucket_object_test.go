@@ -375,6 +375,82 @@ func TestAccAWSS3BucketObject_updateSameFile(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_sourceHashTrigger(t *testing.T) {
+	var originalObj, modifiedObj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	startingData := "Ebben!"
+	changingData := "Ne andrò lontana"
+
+	filename := testAccAWSS3BucketObjectCreateTempFile(t, startingData)
+	defer os.Remove(filename)
+
+	rewriteFile := func(*terraform.State) error {
+		if err := ioutil.WriteFile(filename, []byte(changingData), 0644); err != nil {
+			os.Remove(filename)
+			t.Fatal(err)
+		}
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_sourceHashTrigger(rInt, filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &originalObj),
+					testAccCheckAWSS3BucketObjectBody(&originalObj, startingData),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "7c7e02a79f28968882bb1426c8f8bfc6"),
+					rewriteFile,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSS3BucketObjectConfig_sourceHashTrigger(rInt, filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &modifiedObj),
+					testAccCheckAWSS3BucketObjectBody(&modifiedObj, changingData),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "cffc5e20de2d21764145b1124c9b337b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_multipartUpload(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	// Two parts with the minimum part size of 5 MiB.
+	data := strings.Repeat("0123456789abcdef", 6*1024*1024/16)
+	source := testAccAWSS3BucketObjectCreateTempFile(t, data)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_multipartUpload(rInt, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					testAccCheckAWSS3BucketObjectBody(&obj, data),
+					// The ETag of an object uploaded in parts is suffixed with the number of parts.
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-2$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3BucketObject_updatesWithVersioning(t *testing.T) {
 	var originalObj, modifiedObj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -475,6 +551,28 @@ func TestAccAWSS3BucketObject_kms(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_BucketKeyEnabled(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_BucketKeyEnabled(rInt, "stuff"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					testAccCheckAWSS3BucketObjectBody(&obj, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3BucketObject_sse(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -1373,6 +1471,38 @@ resource "aws_s3_bucket_object" "object" {
 `, randInt, bucketVersioning, source)
 }
 
+func testAccAWSS3BucketObjectConfig_sourceHashTrigger(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%[1]d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket      = aws_s3_bucket.object_bucket.bucket
+  key         = "test-key"
+  source      = %[2]q
+  source_hash = filemd5(%[2]q)
+}
+`, randInt, source)
+}
+
+func testAccAWSS3BucketObjectConfig_multipartUpload(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%[1]d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket             = aws_s3_bucket.object_bucket.bucket
+  key                = "test-key"
+  source             = %[2]q
+  source_hash        = filemd5(%[2]q)
+  upload_concurrency = 2
+  upload_part_size   = 5
+}
+`, randInt, source)
+}
+
 func testAccAWSS3BucketObjectConfig_updateableViaAccessPoint(rName string, bucketVersioning bool, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -1397,6 +1527,27 @@ resource "aws_s3_bucket_object" "test" {
 `, rName, bucketVersioning, source)
 }
 
+func testAccAWSS3BucketObjectConfig_BucketKeyEnabled(randInt int, content string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "Encrypts test bucket objects"
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%[1]d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket             = aws_s3_bucket.object_bucket.bucket
+  key                = "test-key"
+  content            = %[2]q
+  kms_key_id         = aws_kms_key.test.arn
+  bucket_key_enabled = true
+}
+`, randInt, content)
+}
+
 func testAccAWSS3BucketObjectConfig_withKMSId(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "kms_key_1" {}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3BucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketReplicationConfigurationPut,
+		Read:   resourceAwsS3BucketReplicationConfigurationRead,
+		Update: resourceAwsS3BucketReplicationConfigurationPut,
+		Delete: resourceAwsS3BucketReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      rulesHash,
+				Elem:     s3BucketReplicationRuleSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: expandS3ReplicationConfiguration(map[string]interface{}{
+			"role":  d.Get("role"),
+			"rules": d.Get("rules"),
+		}),
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Replication Configuration: %s", input)
+	_, err := tfresource.RetryWhen(1*time.Minute,
+		func() (interface{}, error) {
+			return conn.PutBucketReplication(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+				return true, err
+			}
+
+			// Versioning may have just been enabled on the bucket, e.g. by an aws_s3_bucket_versioning resource.
+			if tfawserr.ErrMessageContains(err, "InvalidRequest", "Versioning must be 'Enabled' on the bucket") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(s3BucketPropagationTimeout, func() (interface{}, error) {
+		return finder.BucketReplicationConfiguration(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) Replication Configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	rc := flattenAwsS3BucketReplicationConfiguration(outputRaw.(*s3.ReplicationConfiguration))[0]
+
+	d.Set("bucket", d.Id())
+	d.Set("role", rc["role"])
+	if err := d.Set("rules", rc["rules"]); err != nil {
+		return fmt.Errorf("error setting rules: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Replication Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketReplication(&s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeReplicationConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
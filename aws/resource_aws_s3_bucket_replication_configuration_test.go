@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSS3BucketReplicationConfiguration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+	destinationResourceName := "aws_s3_bucket.destination"
+	iamRoleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketReplicationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rName, "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "role", iamRoleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rules.*", map[string]string{
+						"id":                          "testid",
+						"status":                      "Enabled",
+						"filter.#":                    "1",
+						"filter.0.prefix":             "testprefix",
+						"destination.#":               "1",
+						"destination.0.storage_class": "STANDARD",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rules.*.destination.0.bucket", destinationResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rName, "STANDARD_IA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketReplicationConfigurationExists(resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rules.*", map[string]string{
+						"destination.0.storage_class": "STANDARD_IA",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketReplicationConfiguration_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketReplicationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rName, "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketReplicationConfigurationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3BucketReplicationConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketReplicationConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Replication Configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := finder.BucketReplicationConfiguration(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAWSS3BucketReplicationConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_replication_configuration" {
+			continue
+		}
+
+		_, err := finder.BucketReplicationConfiguration(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Replication Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketReplicationConfigurationConfig(rName, storageClass string) string {
+	return composeConfig(testAccAWSS3BucketReplicationConfig_iamPolicy(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "destination" {
+  bucket = "%[1]s-destination"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  versioning {
+    enabled = true
+  }
+
+  lifecycle {
+    ignore_changes = [replication_configuration]
+  }
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+  role   = aws_iam_role.test.arn
+
+  rules {
+    id     = "testid"
+    status = "Enabled"
+
+    filter {
+      prefix = "testprefix"
+    }
+
+    destination {
+      bucket        = aws_s3_bucket.destination.arn
+      storage_class = %[2]q
+    }
+  }
+}
+`, rName, storageClass))
+}
@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3BucketServerSideEncryptionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketServerSideEncryptionConfigurationPut,
+		Read:   resourceAwsS3BucketServerSideEncryptionConfigurationRead,
+		Update: resourceAwsS3BucketServerSideEncryptionConfigurationPut,
+		Delete: resourceAwsS3BucketServerSideEncryptionConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     s3BucketServerSideEncryptionRuleSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: expandS3ServerSideEncryptionRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Server-Side Encryption Configuration: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketEncryption(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Server-Side Encryption Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketServerSideEncryptionConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(s3BucketPropagationTimeout, func() (interface{}, error) {
+		return finder.BucketServerSideEncryptionConfiguration(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) Server-Side Encryption Configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Server-Side Encryption Configuration: %w", d.Id(), err)
+	}
+
+	output := outputRaw.(*s3.ServerSideEncryptionConfiguration)
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("rule", flattenS3ServerSideEncryptionRules(output.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketServerSideEncryptionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Server-Side Encryption Configuration: %s", d.Id())
+	_, err := conn.DeleteBucketEncryption(&s3.DeleteBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) || tfawserr.ErrCodeEquals(err, tfs3.ErrCodeServerSideEncryptionConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Server-Side Encryption Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
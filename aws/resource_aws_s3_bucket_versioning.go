@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsS3BucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketVersioningPut,
+		Read:   resourceAwsS3BucketVersioningRead,
+		Update: resourceAwsS3BucketVersioningPut,
+		Delete: resourceAwsS3BucketVersioningDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"mfa_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketVersioningPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketVersioningInput{
+		Bucket: aws.String(bucket),
+		VersioningConfiguration: expandS3VersioningConfiguration(map[string]interface{}{
+			"enabled":    d.Get("enabled"),
+			"mfa_delete": d.Get("mfa_delete"),
+		}),
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Versioning: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketVersioning(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Versioning: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketVersioningRead(d, meta)
+}
+
+func resourceAwsS3BucketVersioningRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(s3BucketPropagationTimeout, func() (interface{}, error) {
+		return finder.BucketVersioning(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) Versioning not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Versioning: %w", d.Id(), err)
+	}
+
+	vc := flattenS3BucketVersioning(outputRaw.(*s3.GetBucketVersioningOutput))
+
+	d.Set("bucket", d.Id())
+	d.Set("enabled", vc["enabled"])
+	d.Set("mfa_delete", vc["mfa_delete"])
+
+	return nil
+}
+
+func resourceAwsS3BucketVersioningDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	// Versioning cannot be disabled once enabled, only suspended.
+	input := &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(d.Id()),
+		VersioningConfiguration: expandS3VersioningConfiguration(nil),
+	}
+
+	log.Printf("[DEBUG] Suspending S3 Bucket Versioning: %s", d.Id())
+	_, err := conn.PutBucketVersioning(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error suspending S3 Bucket (%s) Versioning: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSS3BucketVersioning_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_versioning.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketVersioningDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketVersioningConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketVersioningExists(resourceName, s3.BucketVersioningStatusEnabled),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", bucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_delete", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketVersioningConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketVersioningExists(resourceName, s3.BucketVersioningStatusSuspended),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketVersioning_disappears_Bucket(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_versioning.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketVersioningDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketVersioningConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketVersioningExists(resourceName, s3.BucketVersioningStatusEnabled),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3Bucket(), bucketResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketVersioningExists(n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Versioning ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := finder.BucketVersioning(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.Status); got != status {
+			return fmt.Errorf("S3 Bucket (%s) versioning status is %q, expected %q", rs.Primary.ID, got, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketVersioningDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_versioning" {
+			continue
+		}
+
+		output, err := finder.BucketVersioning(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Status) == s3.BucketVersioningStatusEnabled {
+			return fmt.Errorf("S3 Bucket (%s) versioning still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketVersioningConfig(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket  = aws_s3_bucket.test.id
+  enabled = %[2]t
+}
+`, rName, enabled)
+}
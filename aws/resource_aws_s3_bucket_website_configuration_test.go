@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSS3BucketWebsiteConfiguration_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_website_configuration.test"
+	region := testAccGetRegion()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketWebsiteConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rName, "error.html"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsiteConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_document", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "error_document", "error.html"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to", ""),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", rName, region),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rName, "404.html"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsiteConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "error_document", "404.html"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfigRedirect(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsiteConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_document", ""),
+					resource.TestCheckResourceAttr(resourceName, "error_document", ""),
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to", "https://hashicorp.com?my=query"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketWebsiteConfiguration_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketWebsiteConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rName, "error.html"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketWebsiteConfigurationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3BucketWebsiteConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketWebsiteConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Website Configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := finder.BucketWebsite(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAWSS3BucketWebsiteConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_website_configuration" {
+			continue
+		}
+
+		_, err := finder.BucketWebsite(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Website Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfigBucket(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+  acl    = "public-read"
+
+  lifecycle {
+    ignore_changes = [website]
+  }
+}
+`, rName)
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfig(rName, errorDocument string) string {
+	return composeConfig(testAccAWSS3BucketWebsiteConfigurationConfigBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket         = aws_s3_bucket.test.id
+  index_document = "index.html"
+  error_document = %[1]q
+}
+`, errorDocument))
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfigRedirect(rName string) string {
+	return composeConfig(testAccAWSS3BucketWebsiteConfigurationConfigBucket(rName), `
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket                   = aws_s3_bucket.test.id
+  redirect_all_requests_to = "https://hashicorp.com?my=query"
+}
+`)
+}
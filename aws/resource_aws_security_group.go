@@ -19,6 +19,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsSecurityGroup() *schema.Resource {
@@ -40,6 +43,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsSecurityGroupMigrateState,
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -223,7 +228,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 
 			"revoke_rules_on_delete": {
 				Type:     schema.TypeBool,
@@ -236,6 +242,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 
 func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	securityGroupOpts := &ec2.CreateSecurityGroupInput{}
 
@@ -243,8 +251,8 @@ func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 		securityGroupOpts.VpcId = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		securityGroupOpts.TagSpecifications = ec2TagSpecificationsFromMap(v.(map[string]interface{}), ec2.ResourceTypeSecurityGroup)
+	if len(tags) > 0 {
+		securityGroupOpts.TagSpecifications = ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroup)
 	}
 
 	if v := d.Get("description"); v != nil {
@@ -267,7 +275,7 @@ func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 	log.Printf("[INFO] Security Group ID: %s", d.Id())
 
 	// Wait for the security group to truly exist
-	resp, err := waitForSgToExist(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	group, err := waiter.SecurityGroupCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for Security Group (%s) to become available: %s",
@@ -276,7 +284,6 @@ func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	// AWS defaults all Security Groups to have an ALLOW ALL egress rule. Here we
 	// revoke that rule, so users don't unknowingly have/use it.
-	group := resp.(*ec2.SecurityGroup)
 	if group.VpcId != nil && *group.VpcId != "" {
 		log.Printf("[DEBUG] Revoking default egress rule for Security Group for %s", d.Id())
 
@@ -338,26 +345,23 @@ func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
-	var sgRaw interface{}
-	var err error
-	if d.IsNewResource() {
-		sgRaw, err = waitForSgToExist(conn, d.Id(), d.Timeout(schema.TimeoutRead))
-	} else {
-		sgRaw, _, err = SGStateRefreshFunc(conn, d.Id())()
-	}
-
-	if err != nil {
-		return err
-	}
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(waiter.PropagationTimeout, func() (interface{}, error) {
+		return finder.SecurityGroupByID(conn, d.Id())
+	}, d.IsNewResource())
 
-	if sgRaw == nil {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security group (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	sg := sgRaw.(*ec2.SecurityGroup)
+	if err != nil {
+		return fmt.Errorf("error reading Security Group (%s): %w", d.Id(), err)
+	}
+
+	sg := outputRaw.(*ec2.SecurityGroup)
 
 	remoteIngressRules := resourceAwsSecurityGroupIPPermGather(d.Id(), sg.IpPermissions, sg.OwnerId)
 	remoteEgressRules := resourceAwsSecurityGroupIPPermGather(d.Id(), sg.IpPermissionsEgress, sg.OwnerId)
@@ -393,34 +397,38 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[WARN] Error setting Egress rule set for (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(sg.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.Ec2KeyValueTags(sg.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	var sgRaw interface{}
-	var err error
-	if d.IsNewResource() {
-		sgRaw, err = waitForSgToExist(conn, d.Id(), d.Timeout(schema.TimeoutRead))
-	} else {
-		sgRaw, _, err = SGStateRefreshFunc(conn, d.Id())()
-	}
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(waiter.PropagationTimeout, func() (interface{}, error) {
+		return finder.SecurityGroupByID(conn, d.Id())
+	}, d.IsNewResource())
 
-	if err != nil {
-		return err
-	}
-	if sgRaw == nil {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security group (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	group := sgRaw.(*ec2.SecurityGroup)
+	if err != nil {
+		return fmt.Errorf("error reading Security Group (%s): %w", d.Id(), err)
+	}
+
+	group := outputRaw.(*ec2.SecurityGroup)
 
 	err = resourceAwsSecurityGroupUpdateRules(d, "ingress", meta, group)
 	if err != nil {
@@ -434,8 +442,8 @@ func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") && !d.IsNewResource() {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") && !d.IsNewResource() {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating EC2 Security Group (%s) tags: %s", d.Id(), err)
@@ -491,15 +499,16 @@ func resourceAwsSecurityGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 // Revoke all ingress/egress rules that a Security Group has
 func forceRevokeSecurityGroupRules(conn *ec2.EC2, d *schema.ResourceData) error {
-	sgRaw, _, err := SGStateRefreshFunc(conn, d.Id())()
-	if err != nil {
-		return err
-	}
-	if sgRaw == nil {
+	group, err := finder.SecurityGroupByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
 		return nil
 	}
 
-	group := sgRaw.(*ec2.SecurityGroup)
+	if err != nil {
+		return fmt.Errorf("error reading Security Group (%s): %w", d.Id(), err)
+	}
+
 	if len(group.IpPermissions) > 0 {
 		req := &ec2.RevokeSecurityGroupIngressInput{
 			GroupId:       group.GroupId,
@@ -790,50 +799,6 @@ func resourceAwsSecurityGroupUpdateRules(
 	return nil
 }
 
-// SGStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
-// a security group.
-func SGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		req := &ec2.DescribeSecurityGroupsInput{
-			GroupIds: []*string{aws.String(id)},
-		}
-		resp, err := conn.DescribeSecurityGroups(req)
-		if err != nil {
-			if ec2err, ok := err.(awserr.Error); ok {
-				if ec2err.Code() == "InvalidSecurityGroupID.NotFound" ||
-					ec2err.Code() == "InvalidGroup.NotFound" {
-					resp = nil
-					err = nil
-				}
-			}
-
-			if err != nil {
-				log.Printf("Error on SGStateRefresh: %s", err)
-				return nil, "", err
-			}
-		}
-
-		if resp == nil {
-			return nil, "", nil
-		}
-
-		group := resp.SecurityGroups[0]
-		return group, "exists", nil
-	}
-}
-
-func waitForSgToExist(conn *ec2.EC2, id string, timeout time.Duration) (interface{}, error) {
-	log.Printf("[DEBUG] Waiting for Security Group (%s) to exist", id)
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{""},
-		Target:  []string{"exists"},
-		Refresh: SGStateRefreshFunc(conn, id),
-		Timeout: timeout,
-	}
-
-	return stateConf.WaitForState()
-}
-
 // matchRules receives the group id, type of rules, and the local / remote maps
 // of rules. We iterate through the local set of rules trying to find a matching
 // remote rule, which may be structured differently because of how AWS
@@ -1434,7 +1399,7 @@ func deleteLingeringLambdaENIs(conn *ec2.EC2, filterName, resourceId string, tim
 				Target: []string{
 					ec2.NetworkInterfaceStatusAvailable,
 				},
-				Refresh:    networkInterfaceStateRefresh(conn, eniId),
+				Refresh:    waiter.NetworkInterfaceStatus(conn, eniId),
 				Timeout:    timeout,
 				Delay:      10 * time.Second,
 				MinTimeout: 10 * time.Second,
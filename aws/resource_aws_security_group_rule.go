@@ -985,11 +985,32 @@ func validateSecurityGroupRuleImportString(importStr string) ([]string, error) {
 		return nil, fmt.Errorf(errStr, importStr, "invalid port")
 	}
 
+	var cidrs, securityGroups int
+	var self bool
 	for _, source := range sources {
 		// will be properly validated later
 		if source != "self" && !strings.Contains(source, "sg-") && !strings.Contains(source, "pl-") && !strings.Contains(source, ":") && !strings.Contains(source, ".") {
 			return nil, fmt.Errorf(errStr, importStr, "source must be cidr, ipv6cidr, prefix list, 'self', or a sg ID")
 		}
+
+		if source == "self" {
+			self = true
+		} else if strings.Contains(source, "sg-") {
+			securityGroups++
+		} else if !strings.Contains(source, "pl-") && !strings.Contains(source, ":") {
+			cidrs++
+		}
+	}
+
+	// A single rule resource can only hold one security group source, which conflicts
+	// with cidr_blocks and self. Permissions with other combinations of sources are
+	// managed as multiple rules, each of which must be imported separately.
+	if securityGroups > 1 {
+		return nil, fmt.Errorf(errStr, importStr, "only one security group source is supported, import a rule for each security group")
+	}
+
+	if securityGroups == 1 && (cidrs > 0 || self) {
+		return nil, fmt.Errorf(errStr, importStr, "a security group source cannot be combined with cidr or 'self' sources, import them as separate rules")
 	}
 
 	log.Printf("[DEBUG] Validated import string %s", importStr)
@@ -110,6 +110,49 @@ func TestIpPermissionIDHash(t *testing.T) {
 	}
 }
 
+func TestValidateSecurityGroupRuleImportString(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ErrorRegexp string
+	}{
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_8000_8000_10.0.3.0/24"},
+		{Input: "sg-09a093729ef9382a6_ingress_92_0_65536_10.0.3.0/24_10.0.4.0/24"},
+		{Input: "sg-09a093729ef9382a6_egress_tcp_8000_8000_pl-34800000"},
+		{Input: "sg-09a093729ef9382a6_ingress_all_0_65536_sg-08123412342323"},
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_100_121_10.1.0.0/16_2001:db8::/48_10.2.0.0/16_2002:db8::/48"},
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_80_80_self_2001:db8::/48"},
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_80_80_sg-08123412342323_2001:db8::/48_pl-34800000"},
+		{Input: "sg-09a093729ef9382a6_ingress_icmp_-1_-1_0.0.0.0/0"},
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_8000_8000", ErrorRegexp: "too few parts"},
+		{Input: "09a093729ef9382a6_ingress_tcp_8000_8000_10.0.3.0/24", ErrorRegexp: "invalid security group ID"},
+		{Input: "sg-09a093729ef9382a6_inbound_tcp_8000_8000_10.0.3.0/24", ErrorRegexp: "expecting 'ingress' or 'egress'"},
+		{Input: "sg-09a093729ef9382a6_ingress_sctp_8000_8000_10.0.3.0/24", ErrorRegexp: "protocol must be"},
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_8000_80_10.0.3.0/24", ErrorRegexp: "invalid port"},
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_8000_8000_localhost", ErrorRegexp: "source must be"},
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_8000_8000_sg-08123412342323_sg-0a1b2c3d4e5f", ErrorRegexp: "only one security group source"},
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_8000_8000_sg-08123412342323_10.0.3.0/24", ErrorRegexp: "cannot be combined"},
+		{Input: "sg-09a093729ef9382a6_ingress_tcp_8000_8000_self_sg-08123412342323", ErrorRegexp: "cannot be combined"},
+	}
+
+	for _, tc := range cases {
+		_, err := validateSecurityGroupRuleImportString(tc.Input)
+
+		if tc.ErrorRegexp == "" {
+			if err != nil {
+				t.Errorf("input: %s\nunexpected error: %s", tc.Input, err)
+			}
+
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("input: %s\nexpected error matching %q, got none", tc.Input, tc.ErrorRegexp)
+		} else if !regexp.MustCompile(tc.ErrorRegexp).MatchString(err.Error()) {
+			t.Errorf("input: %s\nexpected error matching %q, got: %s", tc.Input, tc.ErrorRegexp, err)
+		}
+	}
+}
+
 func TestAccAWSSecurityGroupRule_Ingress_VPC(t *testing.T) {
 	var group ec2.SecurityGroup
 	rInt := acctest.RandInt()
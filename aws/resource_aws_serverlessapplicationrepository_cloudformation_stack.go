@@ -45,6 +45,8 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Res
 			Delete: schema.DefaultTimeout(waiter.CloudFormationStackDeletedDefaultTimeout),
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -82,7 +84,8 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Res
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
@@ -124,6 +127,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema
 	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn
 	cfConn := meta.(*AWSClient).cfconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	stack, err := cffinder.Stack(cfConn, d.Id())
 	if tfresource.NotFound(err) {
@@ -154,10 +158,17 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema
 		return fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): missing required tag \"%s\"", d.Id(), serverlessApplicationRepositoryCloudFormationStackTagSemanticVersion)
 	}
 
-	if err = d.Set("tags", tags.IgnoreServerlessApplicationRepository().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreServerlessApplicationRepository().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err = d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("failed to set tags: %w", err)
 	}
 
+	if err = d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("failed to set tags_all: %w", err)
+	}
+
 	if err = d.Set("outputs", flattenCloudFormationOutputs(stack.Outputs)); err != nil {
 		return fmt.Errorf("failed to set outputs: %w", err)
 	}
@@ -285,6 +296,9 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *sche
 }
 
 func createServerlessApplicationRepositoryCloudFormationChangeSet(d *schema.ResourceData, client *AWSClient) (*cloudformation.DescribeChangeSetOutput, error) {
+	defaultTagsConfig := client.DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
 	serverlessConn := client.serverlessapplicationrepositoryconn
 	cfConn := client.cfconn
 
@@ -293,7 +307,7 @@ func createServerlessApplicationRepositoryCloudFormationChangeSet(d *schema.Reso
 		StackName:     aws.String(stackName),
 		ApplicationId: aws.String(d.Get("application_id").(string)),
 		Capabilities:  expandStringSet(d.Get("capabilities").(*schema.Set)),
-		Tags:          keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreServerlessApplicationRepository().ServerlessapplicationrepositoryTags(),
+		Tags:          tags.IgnoreServerlessApplicationRepository().ServerlessapplicationrepositoryTags(),
 	}
 	if v, ok := d.GetOk("semantic_version"); ok {
 		changeSetRequest.SemanticVersion = aws.String(v.(string))
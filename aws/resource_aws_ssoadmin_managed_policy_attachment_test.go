@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+)
+
+func TestAccAWSSSOAdminManagedPolicyAttachment_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_managed_policy_attachment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminManagedPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSOAdminManagedPolicyAttachmentBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminManagedPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", "aws_ssoadmin_permission_set.test", "instance_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", "aws_ssoadmin_permission_set.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "managed_policy_arn", "data.aws_iam_policy.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "managed_policy_name", "AlexaForBusinessDeviceSetup"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSOAdminManagedPolicyAttachment_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_managed_policy_attachment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminManagedPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSOAdminManagedPolicyAttachmentBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminManagedPolicyAttachmentExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsoAdminManagedPolicyAttachment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSOAdminManagedPolicyAttachment_disappears_permissionSet(t *testing.T) {
+	resourceName := "aws_ssoadmin_managed_policy_attachment.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminManagedPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSOAdminManagedPolicyAttachmentBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminManagedPolicyAttachmentExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsoAdminPermissionSet(), permissionSetResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSSOAdminManagedPolicyAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_managed_policy_attachment" {
+			continue
+		}
+
+		managedPolicyArn, permissionSetArn, instanceArn, err := parseSsoAdminManagedPolicyAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Managed Policy Attachment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		policy, err := finder.ManagedPolicy(conn, managedPolicyArn, permissionSetArn, instanceArn)
+
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if policy == nil {
+			continue
+		}
+
+		return fmt.Errorf("Managed Policy (%s) for SSO Permission Set (%s) still exists", managedPolicyArn, permissionSetArn)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSSOAdminManagedPolicyAttachmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		managedPolicyArn, permissionSetArn, instanceArn, err := parseSsoAdminManagedPolicyAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Managed Policy Attachment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+		policy, err := finder.ManagedPolicy(conn, managedPolicyArn, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return err
+		}
+
+		if policy == nil {
+			return fmt.Errorf("Managed Policy (%s) for SSO Permission Set (%s) not found", managedPolicyArn, permissionSetArn)
+		}
+
+		return nil
+	}
+}
+
+func testAccSSOAdminManagedPolicyAttachmentBasicConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_iam_policy" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AlexaForBusinessDeviceSetup"
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_managed_policy_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  managed_policy_arn = data.aws_iam_policy.test.arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+}
+`, rName)
+}
@@ -22,6 +22,8 @@ func resourceAwsSwfDomain() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -58,13 +60,16 @@ func resourceAwsSwfDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
 func resourceAwsSwfDomainCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).swfconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	var name string
 
@@ -79,7 +84,7 @@ func resourceAwsSwfDomainCreate(d *schema.ResourceData, meta interface{}) error
 	input := &swf.RegisterDomainInput{
 		Name:                                   aws.String(name),
 		WorkflowExecutionRetentionPeriodInDays: aws.String(d.Get("workflow_execution_retention_period_in_days").(string)),
-		Tags:                                   keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().SwfTags(),
+		Tags:                                   tags.IgnoreAws().SwfTags(),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -99,6 +104,7 @@ func resourceAwsSwfDomainCreate(d *schema.ResourceData, meta interface{}) error
 func resourceAwsSwfDomainRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).swfconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	input := &swf.DescribeDomainInput{
 		Name: aws.String(d.Id()),
@@ -127,10 +133,17 @@ func resourceAwsSwfDomainRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error listing tags for SWF Domain (%s): %s", arn, err)
 	}
 
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	d.Set("arn", resp.DomainInfo.Arn)
 	d.Set("name", resp.DomainInfo.Name)
 	d.Set("description", resp.DomainInfo.Description)
@@ -142,8 +155,8 @@ func resourceAwsSwfDomainRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsSwfDomainUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).swfconn
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.SwfUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating SWF Domain (%s) tags: %s", d.Id(), err)
@@ -7,6 +7,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
@@ -36,14 +38,10 @@ func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
 func resourceAwsVpcDhcpOptionsAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*AWSClient).ec2conn
 	// Provide the vpc_id as the id to import
-	vpcRaw, _, err := VPCStateRefreshFunc(conn, d.Id())()
+	vpc, err := finder.VpcByID(conn, d.Id())
 	if err != nil {
-		return nil, err
-	}
-	if vpcRaw == nil {
-		return nil, nil
+		return nil, fmt.Errorf("error reading VPC (%s): %w", d.Id(), err)
 	}
-	vpc := vpcRaw.(*ec2.Vpc)
 	if err = d.Set("vpc_id", vpc.VpcId); err != nil {
 		return nil, err
 	}
@@ -80,17 +78,19 @@ func resourceAwsVpcDhcpOptionsAssociationCreate(d *schema.ResourceData, meta int
 func resourceAwsVpcDhcpOptionsAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	// Get the VPC that this association belongs to
-	vpcRaw, _, err := VPCStateRefreshFunc(conn, d.Get("vpc_id").(string))()
+	vpcID := d.Get("vpc_id").(string)
+	vpc, err := finder.VpcByID(conn, vpcID)
 
-	if err != nil {
-		return err
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC (%s) not found, removing DHCP Options association (%s) from state", vpcID, d.Id())
+		d.SetId("")
+		return nil
 	}
 
-	if vpcRaw == nil {
-		return nil
+	if err != nil {
+		return fmt.Errorf("error reading VPC (%s): %w", vpcID, err)
 	}
 
-	vpc := vpcRaw.(*ec2.Vpc)
 	if aws.StringValue(vpc.VpcId) != d.Get("vpc_id") ||
 		aws.StringValue(vpc.DhcpOptionsId) != d.Get("dhcp_options_id") {
 		log.Printf("[INFO] It seems the DHCP Options association is gone. Deleting reference from Graph...")
@@ -7,6 +7,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsVpnGatewayRoutePropagation() *schema.Resource {
@@ -74,17 +76,18 @@ func resourceAwsVpnGatewayRoutePropagationRead(d *schema.ResourceData, meta inte
 	rtID := d.Get("route_table_id").(string)
 
 	log.Printf("[INFO] Reading route table %s to check for VPN gateway %s", rtID, gwID)
-	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, rtID)()
-	if err != nil {
-		return err
-	}
-	if rtRaw == nil {
+	rt, err := finder.RouteTableByID(conn, rtID)
+
+	if tfresource.NotFound(err) {
 		log.Printf("[INFO] Route table %q doesn't exist, so dropping %q route propagation from state", rtID, gwID)
 		d.SetId("")
 		return nil
 	}
 
-	rt := rtRaw.(*ec2.RouteTable)
+	if err != nil {
+		return fmt.Errorf("error reading Route Table (%s): %w", rtID, err)
+	}
+
 	exists := false
 	for _, vgw := range rt.PropagatingVgws {
 		if *vgw.GatewayId == gwID {
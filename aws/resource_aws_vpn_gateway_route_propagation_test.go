@@ -5,9 +5,10 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func TestAccAWSVPNGatewayRoutePropagation_basic(t *testing.T) {
@@ -31,15 +32,11 @@ func TestAccAWSVPNGatewayRoutePropagation_basic(t *testing.T) {
 					rtID = rs.Primary.Attributes["route_table_id"]
 					gwID = rs.Primary.Attributes["vpn_gateway_id"]
 
-					rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, rtID)()
+					rt, err := finder.RouteTableByID(conn, rtID)
 					if err != nil {
-						return fmt.Errorf("failed to read route table: %s", err)
-					}
-					if rtRaw == nil {
-						return errors.New("route table doesn't exist")
+						return fmt.Errorf("failed to read route table: %w", err)
 					}
 
-					rt := rtRaw.(*ec2.RouteTable)
 					exists := false
 					for _, vgw := range rt.PropagatingVgws {
 						if *vgw.GatewayId == gwID {
@@ -57,14 +54,14 @@ func TestAccAWSVPNGatewayRoutePropagation_basic(t *testing.T) {
 		CheckDestroy: func(state *terraform.State) error {
 			conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
-			rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, rtID)()
-			if err != nil {
-				return fmt.Errorf("failed to read route table: %s", err)
+			_, err := finder.RouteTableByID(conn, rtID)
+			if tfresource.NotFound(err) {
+				return nil
 			}
-			if rtRaw != nil {
-				return errors.New("route table still exists")
+			if err != nil {
+				return fmt.Errorf("failed to read route table: %w", err)
 			}
-			return nil
+			return errors.New("route table still exists")
 		},
 	})
 
@@ -1,6 +1,9 @@
 package aws
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +29,38 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
+// SetTagsDiff sets the new plan difference of tags_all with the result of
+// merging the resource tags on to those defined at the provider-level.
+// Tag keys ignored at the provider-level are excluded so that the planned
+// value matches what is later read from the AWS API.
+func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	if !diff.NewValueKnown("tags") {
+		if err := diff.SetNewComputed("tags_all"); err != nil {
+			return fmt.Errorf("error setting tags_all to computed: %w", err)
+		}
+
+		return nil
+	}
+
+	resourceTags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
+	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+
+	if len(allTags) > 0 {
+		if err := diff.SetNew("tags_all", allTags.Map()); err != nil {
+			return fmt.Errorf("error setting new tags_all diff: %w", err)
+		}
+	} else if len(diff.Get("tags_all").(map[string]interface{})) > 0 {
+		if err := diff.SetNewComputed("tags_all"); err != nil {
+			return fmt.Errorf("error setting tags_all to computed: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func tagsSchemaForceNew() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
@@ -55,14 +90,19 @@ func ec2TagsFromTagDescriptions(tds []*ec2.TagDescription) []*ec2.Tag {
 
 // ec2TagSpecificationsFromMap returns the tag specifications for the given tag key/value map and resource type.
 func ec2TagSpecificationsFromMap(m map[string]interface{}, t string) []*ec2.TagSpecification {
-	if len(m) == 0 {
+	return ec2TagSpecificationsFromKeyValueTags(keyvaluetags.New(m), t)
+}
+
+// ec2TagSpecificationsFromKeyValueTags returns the tag specifications for the given KeyValueTags object and resource type.
+func ec2TagSpecificationsFromKeyValueTags(tags keyvaluetags.KeyValueTags, t string) []*ec2.TagSpecification {
+	if len(tags) == 0 {
 		return nil
 	}
 
 	return []*ec2.TagSpecification{
 		{
 			ResourceType: aws.String(t),
-			Tags:         keyvaluetags.New(m).IgnoreAws().Ec2Tags(),
+			Tags:         tags.IgnoreAws().Ec2Tags(),
 		},
 	}
 }